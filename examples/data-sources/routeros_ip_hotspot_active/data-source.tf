@@ -0,0 +1,9 @@
+data "routeros_ip_hotspot_active" "guests" {
+  filter = {
+    server = "guests"
+  }
+}
+
+output "guests_traffic" {
+  value = { for a in data.routeros_ip_hotspot_active.guests.active : a.user => a.bytes_in + a.bytes_out }
+}
@@ -0,0 +1,9 @@
+data "routeros_routing_ospf_lsa" "routers" {
+  filter = {
+    type = "router"
+  }
+}
+
+output "originators" {
+  value = [for l in data.routeros_routing_ospf_lsa.routers.lsa : l.originator]
+}
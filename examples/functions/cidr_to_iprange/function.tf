@@ -0,0 +1,4 @@
+resource "routeros_ip_pool" "pool" {
+  name   = "my_ip_pool"
+  ranges = [provider::routeros::cidr_to_iprange("10.0.0.0/24")]
+}
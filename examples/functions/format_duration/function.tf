@@ -0,0 +1,6 @@
+resource "routeros_ip_dhcp_server" "server" {
+  name         = "dhcp"
+  interface    = "bridge"
+  address_pool = "pool"
+  lease_time   = provider::routeros::format_duration(12 * 3600) # 12h
+}
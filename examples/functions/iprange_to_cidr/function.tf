@@ -0,0 +1,4 @@
+locals {
+  # ["10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"]
+  prefixes = provider::routeros::iprange_to_cidr("10.0.0.1-10.0.0.6")
+}
@@ -0,0 +1,4 @@
+resource "routeros_ip_dhcp_server_lease" "printer" {
+  address     = "10.0.0.10"
+  mac_address = provider::routeros::normalize_mac("4c5e.0c00.0001")
+}
@@ -0,0 +1,4 @@
+locals {
+  # 93600
+  lease_seconds = provider::routeros::parse_duration("1d2h")
+}
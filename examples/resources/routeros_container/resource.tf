@@ -0,0 +1,21 @@
+resource "routeros_interface_veth" "pihole" {
+  name    = "veth-pihole"
+  address = "172.17.0.2/24"
+  gateway = "172.17.0.1"
+}
+
+resource "routeros_container" "pihole" {
+  remote_image    = "pihole/pihole:latest"
+  interface       = routeros_interface_veth.pihole.name
+  envlist         = routeros_container_envs.pihole_tz.name
+  mounts          = [routeros_container_mounts.pihole_etc.name]
+  root_dir        = "disk1/pihole"
+  hostname        = "pihole"
+  logging         = true
+  start_on_boot   = true
+  start_on_create = true
+
+  timeouts {
+    create = "30m"
+  }
+}
@@ -0,0 +1 @@
+terraform import routeros_container_config.config .
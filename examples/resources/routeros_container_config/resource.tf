@@ -0,0 +1,5 @@
+resource "routeros_container_config" "config" {
+  registry_url = "https://registry-1.docker.io"
+  tmpdir       = "disk1/pull"
+  ram_high     = "512.0MiB"
+}
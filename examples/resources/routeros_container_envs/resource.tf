@@ -0,0 +1,11 @@
+resource "routeros_container_envs" "pihole_tz" {
+  name  = "pihole"
+  key   = "TZ"
+  value = "Europe/Riga"
+}
+
+resource "routeros_container_envs" "pihole_password" {
+  name  = "pihole"
+  key   = "WEBPASSWORD"
+  value = var.pihole_password
+}
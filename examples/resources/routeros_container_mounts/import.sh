@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/container/mounts get [print show-ids]]
+terraform import routeros_container_mounts.pihole_etc "pihole-etc"
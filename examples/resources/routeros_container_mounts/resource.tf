@@ -0,0 +1,5 @@
+resource "routeros_container_mounts" "pihole_etc" {
+  name = "pihole-etc"
+  src  = "/disk1/pihole/etc"
+  dst  = "/etc/pihole"
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/6to4 get [print show-ids]]
+terraform import routeros_interface_6to4.sit1 "sit1"
@@ -0,0 +1,6 @@
+resource "routeros_interface_6to4" "sit1" {
+  name           = "sit1"
+  local_address  = "192.0.2.1"
+  remote_address = "192.88.99.1"
+  keepalive      = "10s,10"
+}
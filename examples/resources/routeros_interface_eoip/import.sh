@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/eoip get [print show-ids]]
+terraform import routeros_interface_eoip.eoip_dc "eoip-dc-1"
@@ -0,0 +1,7 @@
+resource "routeros_interface_eoip" "eoip_dc" {
+  name            = "eoip-dc-1"
+  remote_address  = "10.77.3.26"
+  tunnel_id       = 100
+  allow_fast_path = false
+  ipsec_secret    = "secret"
+}
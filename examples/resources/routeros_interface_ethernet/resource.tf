@@ -0,0 +1,13 @@
+resource "routeros_interface_ethernet" "ether2" {
+  factory_name     = "ether2"
+  name             = "uplink"
+  mtu              = "9000"
+  auto_negotiation = false
+  speed            = "1G-baseT-full"
+  full_duplex      = true
+  tx_flow_control  = "on"
+  rx_flow_control  = "on"
+  poe_out          = "off"
+  comment          = "Uplink to the core switch"
+  reset_on_destroy = true
+}
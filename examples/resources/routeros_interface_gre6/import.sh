@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/gre6 get [print show-ids]]
+terraform import routeros_interface_gre6.gre6_hq "gre6-hq-1"
@@ -0,0 +1,5 @@
+resource "routeros_interface_gre6" "gre6_hq" {
+  name           = "gre6-hq-1"
+  local_address  = "2001:db8:1::1"
+  remote_address = "2001:db8:2::1"
+}
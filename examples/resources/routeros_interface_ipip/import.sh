@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/ipip get [print show-ids]]
+terraform import routeros_interface_ipip.ipip_hq "ipip-hq-1"
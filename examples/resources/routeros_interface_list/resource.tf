@@ -1,3 +1,14 @@
 resource "routeros_interface_list" "list" {
   name = "my-list"
-}
\ No newline at end of file
+}
+
+resource "routeros_interface_list" "wan" {
+  name = "WAN"
+  members {
+    interface = "ether1"
+  }
+  members {
+    interface = "pppoe-out1"
+    comment   = "ISP uplink"
+  }
+}
@@ -0,0 +1,5 @@
+resource "routeros_interface_vxlan" "vxlan_fabric" {
+  name = "vxlan-fabric"
+  vni  = 10100
+  port = 4789
+}
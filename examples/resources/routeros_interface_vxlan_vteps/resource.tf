@@ -0,0 +1,10 @@
+resource "routeros_interface_vxlan" "vxlan_fabric" {
+  name = "vxlan-fabric"
+  vni  = 10100
+  port = 4789
+}
+
+resource "routeros_interface_vxlan_vteps" "leaf2" {
+  interface = routeros_interface_vxlan.vxlan_fabric.name
+  remote_ip = "10.0.0.2"
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/wireless get [print show-ids]]
+terraform import routeros_interface_wireless.wlan1 "wlan1"
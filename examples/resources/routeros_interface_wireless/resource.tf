@@ -0,0 +1,24 @@
+resource "routeros_interface_wireless_security_profiles" "home" {
+  name                 = "home"
+  mode                 = "dynamic-keys"
+  authentication_types = ["wpa2-psk"]
+  wpa2_pre_shared_key  = "secret-key"
+}
+
+# The physical radio is adopted by name, it is never created or removed.
+resource "routeros_interface_wireless" "wlan1" {
+  name             = "wlan1"
+  mode             = "ap-bridge"
+  band             = "2ghz-b/g/n"
+  country          = "latvia"
+  frequency        = "auto"
+  ssid             = "home"
+  security_profile = routeros_interface_wireless_security_profiles.home.name
+}
+
+resource "routeros_interface_wireless" "guests" {
+  name             = "wlan-guests"
+  master_interface = routeros_interface_wireless.wlan1.name
+  ssid             = "guests"
+  security_profile = routeros_interface_wireless_security_profiles.home.name
+}
@@ -0,0 +1,14 @@
+resource "routeros_interface_wireless_access_list" "device" {
+  interface              = "wlan1"
+  mac_address            = "00:11:22:33:44:55"
+  private_pre_shared_key = "device-key"
+  vlan_mode              = "use-tag"
+  vlan_id                = 30
+}
+
+resource "routeros_interface_wireless_access_list" "weak_signal" {
+  interface      = "wlan1"
+  signal_range   = "-120..-80"
+  authentication = false
+  place_before   = routeros_interface_wireless_access_list.device.id
+}
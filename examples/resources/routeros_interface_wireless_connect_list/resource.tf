@@ -0,0 +1,6 @@
+resource "routeros_interface_wireless_connect_list" "uplink" {
+  interface        = "wlan1"
+  ssid             = "uplink"
+  signal_range     = "-80..120"
+  security_profile = "uplink"
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/wireless/security-profiles get [print show-ids]]
+terraform import routeros_interface_wireless_security_profiles.home "home"
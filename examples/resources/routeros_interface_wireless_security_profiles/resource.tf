@@ -0,0 +1,9 @@
+resource "routeros_interface_wireless_security_profiles" "home" {
+  name                 = "home"
+  mode                 = "dynamic-keys"
+  authentication_types = ["wpa-psk", "wpa2-psk"]
+  unicast_ciphers      = ["aes-ccm"]
+  group_ciphers        = ["aes-ccm"]
+  wpa_pre_shared_key   = "secret-key"
+  wpa2_pre_shared_key  = "secret-key"
+}
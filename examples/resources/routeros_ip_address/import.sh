@@ -1,3 +1,7 @@
+#With the 'comment_tag_id' provider option, the resource is identified by the 'tf:<uuid>' tag at the beginning of the comment
+#on the router, so the state survives a backup restore or a router replacement. Resources imported by the ID are tagged on the next update.
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/ip/address get [print show-ids]]
-terraform import routeros_ip_address.address "*0"
\ No newline at end of file
+terraform import routeros_ip_address.address "*0"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_address.address "address=192.168.88.1/24"
\ No newline at end of file
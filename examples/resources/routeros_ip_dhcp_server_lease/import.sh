@@ -1,3 +1,5 @@
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/ip/dhcp-server/lease get [print show-ids]]
-terraform import routeros_ip_dhcp_server_lease.dhcp_lease "*0"
\ No newline at end of file
+terraform import routeros_ip_dhcp_server_lease.dhcp_lease "*0"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_dhcp_server_lease.dhcp_lease "mac_address=AA:BB:CC:DD:EE:FF"
\ No newline at end of file
@@ -1,3 +1,7 @@
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/ip/firewall/filter get [print show-ids]]
-terraform import routeros_ip_firewall_filter.rule "*0"
\ No newline at end of file
+terraform import routeros_ip_firewall_filter.rule "*0"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_firewall_filter.rule "chain=input,comment=Allow SSH"
+#Values containing commas are enclosed in double quotes
+terraform import routeros_ip_firewall_filter.rule 'chain=input,comment="Allow SSH, HTTPS"'
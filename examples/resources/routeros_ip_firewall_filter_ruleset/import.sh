@@ -0,0 +1,3 @@
+# Import all static rules of the listed chains.
+terraform import routeros_ip_firewall_filter_ruleset.input input
+terraform import routeros_ip_firewall_filter_ruleset.chains input,forward
@@ -0,0 +1,23 @@
+resource "routeros_ip_firewall_filter_ruleset" "input" {
+  rule {
+    action           = "accept"
+    chain            = "input"
+    connection_state = "established,related"
+    comment          = "Accept established"
+  }
+
+  rule {
+    action      = "accept"
+    chain       = "input"
+    src_address = "10.0.0.0/8"
+    protocol    = "tcp"
+    dst_port    = "22"
+    comment     = "Accept SSH from LAN"
+  }
+
+  rule {
+    action  = "drop"
+    chain   = "input"
+    comment = "Drop everything else"
+  }
+}
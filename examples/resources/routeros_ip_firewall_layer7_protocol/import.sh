@@ -0,0 +1,2 @@
+#The resource is identified by its name
+terraform import routeros_ip_firewall_layer7_protocol.http http
@@ -0,0 +1,4 @@
+resource "routeros_ip_firewall_layer7_protocol" "http" {
+  name   = "http"
+  regexp = "^(get|post) .+ http/1\\.[01]"
+}
@@ -1,3 +1,5 @@
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/ip/firewall/mangle get [print show-ids]]
-terraform import routeros_ip_firewall_mangle.rule "*0"
\ No newline at end of file
+terraform import routeros_ip_firewall_mangle.rule "*0"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_firewall_mangle.rule "chain=prerouting,comment=Mark VoIP"
\ No newline at end of file
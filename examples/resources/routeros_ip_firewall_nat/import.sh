@@ -1,3 +1,5 @@
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/ip/firewall/nat get [print show-ids]]
-terraform import routeros_ip_firewall_nat.rule "*0"
\ No newline at end of file
+terraform import routeros_ip_firewall_nat.rule "*0"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_firewall_nat.rule "chain=srcnat,comment=Masquerade"
\ No newline at end of file
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/ip/hotspot get [print show-ids]]
+terraform import routeros_ip_hotspot.guests "guests"
@@ -0,0 +1,8 @@
+resource "routeros_ip_hotspot" "guests" {
+  name              = "guests"
+  interface         = "bridge-guests"
+  address_pool      = "pool-guests"
+  addresses_per_mac = "2"
+  idle_timeout      = "5m"
+  profile           = "guests"
+}
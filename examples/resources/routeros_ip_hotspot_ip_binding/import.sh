@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/ip/hotspot/ip-binding get [print show-ids]]
+terraform import routeros_ip_hotspot_ip_binding.printer "*1"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_hotspot_ip_binding.printer "mac_address=00:11:22:33:44:55"
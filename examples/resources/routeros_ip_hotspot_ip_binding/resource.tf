@@ -0,0 +1,5 @@
+resource "routeros_ip_hotspot_ip_binding" "printer" {
+  mac_address = "00:11:22:33:44:55"
+  type        = "bypassed"
+  comment     = "Printer"
+}
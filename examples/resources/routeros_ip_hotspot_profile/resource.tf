@@ -0,0 +1,9 @@
+resource "routeros_ip_hotspot_profile" "guests" {
+  name                  = "guests"
+  dns_name              = "guests.example.com"
+  hotspot_address       = "192.168.10.1"
+  html_directory        = "flash/hotspot-guests"
+  html_directory_source = "${path.module}/hotspot-guests"
+  login_by              = ["cookie", "http-chap", "https"]
+  ssl_certificate       = "hotspot"
+}
@@ -0,0 +1,7 @@
+resource "routeros_ip_hotspot_user" "guest" {
+  name         = "guest"
+  password     = "guest-password"
+  profile      = "guests"
+  server       = "guests"
+  limit_uptime = "1d"
+}
@@ -0,0 +1,8 @@
+resource "routeros_ip_hotspot_user_profile" "guests" {
+  name               = "guests"
+  add_mac_cookie     = true
+  address_list       = "hotspot-guests"
+  mac_cookie_timeout = "3d"
+  rate_limit         = "2M/10M"
+  shared_users       = "1"
+}
@@ -0,0 +1,6 @@
+resource "routeros_ip_hotspot_walled_garden" "example" {
+  server   = "guests"
+  dst_host = "*.example.com"
+  dst_port = "443"
+  action   = "allow"
+}
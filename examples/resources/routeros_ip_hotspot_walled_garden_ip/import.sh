@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/ip/hotspot/walled-garden/ip get [print show-ids]]
+terraform import routeros_ip_hotspot_walled_garden_ip.dns "*1"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_hotspot_walled_garden_ip.dns "dst_address=192.0.2.10,dst_port=53"
@@ -0,0 +1,7 @@
+resource "routeros_ip_hotspot_walled_garden_ip" "dns" {
+  server      = "guests"
+  dst_address = "192.0.2.10"
+  protocol    = "udp"
+  dst_port    = "53"
+  action      = "accept"
+}
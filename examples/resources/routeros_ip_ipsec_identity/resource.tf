@@ -0,0 +1,9 @@
+variable "office_b_psk" {
+  type      = string
+  sensitive = true
+}
+
+resource "routeros_ip_ipsec_identity" "office_b" {
+  peer   = routeros_ip_ipsec_peer.office_b.name
+  secret = var.office_b_psk
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/ip/ipsec/mode-config get [print show-ids]]
+terraform import routeros_ip_ipsec_mode_config.road_warrior "road-warrior"
@@ -0,0 +1,6 @@
+resource "routeros_ip_ipsec_mode_config" "road_warrior" {
+  name          = "road-warrior"
+  address_pool  = "ipsec-pool"
+  split_include = ["10.0.0.0/24"]
+  system_dns    = true
+}
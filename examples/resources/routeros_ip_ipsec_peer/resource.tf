@@ -0,0 +1,7 @@
+resource "routeros_ip_ipsec_peer" "office_b" {
+  name          = "office-b"
+  address       = "203.0.113.10/32"
+  exchange_mode = "ike2"
+  # The reference orders the creation and the destruction of the objects.
+  profile = routeros_ip_ipsec_profile.s2s.name
+}
@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/ip/ipsec/policy get [print show-ids]]
+terraform import routeros_ip_ipsec_policy.office_b "*2"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_ip_ipsec_policy.office_b "peer=office-b"
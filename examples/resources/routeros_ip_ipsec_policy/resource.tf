@@ -0,0 +1,7 @@
+resource "routeros_ip_ipsec_policy" "office_b" {
+  peer        = routeros_ip_ipsec_peer.office_b.name
+  proposal    = routeros_ip_ipsec_proposal.s2s.name
+  src_address = "10.1.0.0/16"
+  dst_address = "10.2.0.0/16"
+  tunnel      = true
+}
@@ -0,0 +1,3 @@
+resource "routeros_ip_ipsec_policy_group" "road_warrior" {
+  name = "road-warrior"
+}
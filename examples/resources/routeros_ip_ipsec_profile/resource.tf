@@ -0,0 +1,7 @@
+resource "routeros_ip_ipsec_profile" "s2s" {
+  name           = "s2s"
+  dh_group       = ["modp2048", "ecp256"]
+  enc_algorithm  = ["aes-256"]
+  hash_algorithm = "sha256"
+  lifetime       = "8h"
+}
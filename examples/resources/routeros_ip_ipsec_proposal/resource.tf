@@ -0,0 +1,7 @@
+resource "routeros_ip_ipsec_proposal" "s2s" {
+  name            = "s2s"
+  auth_algorithms = ["sha256"]
+  enc_algorithms  = ["aes-256-cbc", "aes-256-gcm"]
+  lifetime        = "1h"
+  pfs_group       = "modp2048"
+}
@@ -1,3 +1,5 @@
+#With the 'comment_tag_id' provider option, the resource is identified by the 'tf:<uuid>' tag at the beginning of the comment
+#on the router, so the state survives a backup restore or a router replacement. Resources imported by the ID are tagged on the next update.
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/ipv6/route get [print show-ids]]
 terraform import routeros_ipv6_route.a_route "*0"
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "routeros_queue_simple" "customer_42" {
+  name      = "customer-42"
+  target    = "10.0.42.0/24"
+  max_limit = "10M/10M"
+  limit_at  = "2M/2M"
+}
+
+resource "routeros_queue_simple" "voip" {
+  name         = "voip"
+  target       = "10.0.50.10"
+  max_limit    = "1M/1M"
+  priority     = "1/1"
+  place_before = routeros_queue_simple.customer_42.id
+}
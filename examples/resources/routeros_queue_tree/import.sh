@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/queue/tree get [print show-ids]]
+terraform import routeros_queue_tree.download "*1"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_queue_tree.download "name=download"
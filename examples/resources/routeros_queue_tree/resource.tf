@@ -0,0 +1,15 @@
+resource "routeros_queue_tree" "download" {
+  name      = "download"
+  parent    = "global"
+  max_limit = "100M"
+}
+
+resource "routeros_queue_tree" "download_web" {
+  name        = "download-web"
+  parent      = routeros_queue_tree.download.name
+  packet_mark = "web"
+  queue       = routeros_queue_type.pcq_download.name
+  limit_at    = "20M"
+  max_limit   = "80M"
+  priority    = 4
+}
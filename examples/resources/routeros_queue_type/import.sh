@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/queue/type get [print show-ids]]
+terraform import routeros_queue_type.pcq_download "pcq-download-10M"
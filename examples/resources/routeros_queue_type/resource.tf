@@ -0,0 +1,13 @@
+resource "routeros_queue_type" "pcq_download" {
+  name           = "pcq-download-10M"
+  kind           = "pcq"
+  pcq_rate       = "10M"
+  pcq_classifier = "dst-address"
+}
+
+resource "routeros_queue_type" "cake" {
+  name          = "cake-wan"
+  kind          = "cake"
+  cake_diffserv = "diffserv4"
+  cake_nat      = true
+}
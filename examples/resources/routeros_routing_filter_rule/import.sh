@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/routing/filter/rule get [print show-ids]]
+terraform import routeros_routing_filter_rule.accept "*1"
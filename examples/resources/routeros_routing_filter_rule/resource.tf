@@ -0,0 +1,16 @@
+resource "routeros_routing_filter_rule" "reject_long_prefixes" {
+  chain = "bgp-in"
+  rule  = "if (dst-len > 24) { reject }"
+}
+
+resource "routeros_routing_filter_rule" "accept" {
+  chain = "bgp-in"
+  rule  = "if (dst in 10.0.0.0/8) { set distance 110; accept } else { reject }"
+}
+
+resource "routeros_routing_bgp_connection" "upstream" {
+  # ...
+  input {
+    filter = routeros_routing_filter_rule.accept.chain
+  }
+}
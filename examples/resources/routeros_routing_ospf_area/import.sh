@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/routing/ospf/area get [print show-ids]]
+terraform import routeros_routing_ospf_area.backbone "backbone"
@@ -0,0 +1,5 @@
+resource "routeros_routing_ospf_area" "backbone" {
+  name     = "backbone"
+  instance = routeros_routing_ospf_instance.core.name
+  area_id  = "0.0.0.0"
+}
@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/routing/ospf/area/range get [print show-ids]]
+terraform import routeros_routing_ospf_area_range.office "*1"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_routing_ospf_area_range.office "prefix=10.10.0.0/16"
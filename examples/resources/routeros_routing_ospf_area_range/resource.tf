@@ -0,0 +1,4 @@
+resource "routeros_routing_ospf_area_range" "office" {
+  area   = routeros_routing_ospf_area.backbone.name
+  prefix = "10.10.0.0/16"
+}
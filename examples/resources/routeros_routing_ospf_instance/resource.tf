@@ -0,0 +1,5 @@
+resource "routeros_routing_ospf_instance" "core" {
+  name         = "core-v2"
+  router_id    = "10.255.0.1"
+  redistribute = "connected,static"
+}
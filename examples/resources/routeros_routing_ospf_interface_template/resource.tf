@@ -0,0 +1,9 @@
+resource "routeros_routing_ospf_interface_template" "lan" {
+  area       = routeros_routing_ospf_area.backbone.name
+  interfaces = ["ether2", "ether3"]
+  networks   = ["10.10.1.0/24", "10.10.2.0/24"]
+  auth       = "sha256"
+  auth_id    = 1
+  auth_key   = var.ospf_auth_key
+  cost       = 10
+}
@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/routing/ospf/static-neighbor get [print show-ids]]
+terraform import routeros_routing_ospf_static_neighbor.nbma "*1"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_routing_ospf_static_neighbor.nbma "address=10.10.1.2"
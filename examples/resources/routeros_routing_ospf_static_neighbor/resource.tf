@@ -0,0 +1,4 @@
+resource "routeros_routing_ospf_static_neighbor" "nbma" {
+  area    = routeros_routing_ospf_area.backbone.name
+  address = "10.10.1.2"
+}
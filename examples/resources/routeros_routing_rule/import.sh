@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/routing/rule get [print show-ids]]
+terraform import routeros_routing_rule.lan_via_isp1 "*1"
@@ -0,0 +1,10 @@
+resource "routeros_routing_table" "isp1" {
+  name = "to_ISP1"
+  fib  = true
+}
+
+resource "routeros_routing_rule" "lan_via_isp1" {
+  src_address = "192.168.1.0/24"
+  action      = "lookup-only-in-table"
+  table       = routeros_routing_table.isp1.name
+}
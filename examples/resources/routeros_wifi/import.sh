@@ -0,0 +1,4 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/wifi get [print show-ids]]
+#The physical interfaces (wifi1, wifi2) can not be created and should be imported
+terraform import routeros_wifi.guests "wifi-guests"
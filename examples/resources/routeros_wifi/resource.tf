@@ -0,0 +1,18 @@
+resource "routeros_wifi" "guests" {
+  name             = "wifi-guests"
+  master_interface = "wifi1"
+
+  configuration = {
+    config = "cfg-home"
+    ssid   = "guests"
+  }
+
+  datapath = {
+    config = "dp-guests"
+  }
+
+  security = {
+    authentication_types = "wpa2-psk,wpa3-psk"
+    passphrase           = "guests-passphrase"
+  }
+}
@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/wifi/access-list get [print show-ids]]
+terraform import routeros_wifi_access_list.device "*1"
+#Or you can import a resource using one or more of its fields as a natural key. The query must match exactly one item
+terraform import routeros_wifi_access_list.device "mac_address=00:11:22:33:44:55"
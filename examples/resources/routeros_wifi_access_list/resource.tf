@@ -0,0 +1,13 @@
+resource "routeros_wifi_access_list" "device" {
+  action      = "accept"
+  interface   = "wifi-guests"
+  mac_address = "00:11:22:33:44:55"
+  passphrase  = "device-passphrase"
+  vlan_id     = "30"
+}
+
+resource "routeros_wifi_access_list" "weak_signal" {
+  action       = "reject"
+  signal_range = "-120..-80"
+  place_before = routeros_wifi_access_list.device.id
+}
@@ -0,0 +1 @@
+terraform import routeros_wifi_cap.settings .
@@ -0,0 +1,5 @@
+resource "routeros_wifi_cap" "settings" {
+  enabled              = true
+  discovery_interfaces = ["bridge"]
+  slaves_datapath      = "dp-home"
+}
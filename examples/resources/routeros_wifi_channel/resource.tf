@@ -0,0 +1,7 @@
+resource "routeros_wifi_channel" "ch_5ghz" {
+  name              = "ch-5ghz"
+  band              = "5ghz-ax"
+  frequency         = ["5180-5240"]
+  skip_dfs_channels = "10min-cac"
+  width             = "20/40/80mhz"
+}
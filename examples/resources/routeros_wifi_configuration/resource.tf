@@ -0,0 +1,38 @@
+resource "routeros_wifi_channel" "ch_5ghz" {
+  name  = "ch-5ghz"
+  band  = "5ghz-ax"
+  width = "20/40/80mhz"
+}
+
+resource "routeros_wifi_security" "home" {
+  name                 = "sec-home"
+  authentication_types = ["wpa2-psk", "wpa3-psk"]
+  passphrase           = "secret-passphrase"
+}
+
+resource "routeros_wifi_datapath" "home" {
+  name   = "dp-home"
+  bridge = "bridge"
+}
+
+resource "routeros_wifi_configuration" "home" {
+  name    = "cfg-home"
+  country = "Latvia"
+  mode    = "ap"
+  ssid    = "home"
+
+  channel = {
+    config            = routeros_wifi_channel.ch_5ghz.name
+    skip_dfs_channels = "all"
+  }
+
+  datapath = {
+    config = routeros_wifi_datapath.home.name
+  }
+
+  security = {
+    config     = routeros_wifi_security.home.name
+    ft         = "true"
+    ft_over_ds = "true"
+  }
+}
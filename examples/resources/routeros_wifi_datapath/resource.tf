@@ -0,0 +1,6 @@
+resource "routeros_wifi_datapath" "guests" {
+  name             = "dp-guests"
+  bridge           = "bridge"
+  client_isolation = true
+  vlan_id          = 20
+}
@@ -0,0 +1,7 @@
+resource "routeros_wifi_provisioning" "ax_5ghz" {
+  action               = "create-dynamic-enabled"
+  master_configuration = "cfg-home"
+  slave_configurations = ["cfg-guests"]
+  name_format          = "%I-5ghz"
+  supported_bands      = ["5ghz-ax"]
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/interface/wifi/security get [print show-ids]]
+terraform import routeros_wifi_security.home "sec-home"
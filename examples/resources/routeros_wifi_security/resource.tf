@@ -0,0 +1,7 @@
+resource "routeros_wifi_security" "home" {
+  name                 = "sec-home"
+  authentication_types = ["wpa2-psk", "wpa3-psk"]
+  passphrase           = "secret-passphrase"
+  ft                   = true
+  ft_over_ds           = true
+}
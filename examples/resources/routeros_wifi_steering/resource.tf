@@ -0,0 +1,5 @@
+resource "routeros_wifi_steering" "home" {
+  name = "steering-home"
+  rrm  = true
+  wnm  = true
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/terraform-routeros/terraform-provider-routeros/routeros"
 )
 
@@ -18,9 +20,18 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderAddr: "terraform-routeros/routeros",
-		ProviderFunc: routeros.NewProvider,
-		Debug:        debug,
-	})
+	// The SDK and the plugin framework providers are served as a single provider.
+	server, err := routeros.NewProviderServer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err = tf5server.Serve("registry.terraform.io/terraform-routeros/routeros", server, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,146 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*A",
+    "address": "192.168.10.254",
+    "blocked": "false",
+    "bytes-in": "1563468",
+    "bytes-out": "29834751",
+    "idle-time": "0s",
+    "idle-timeout": "none",
+    "keepalive-timeout": "2m",
+    "login-by": "http-chap",
+    "mac-address": "00:11:22:33:44:55",
+    "packets-in": "12043",
+    "packets-out": "23187",
+    "radius": "false",
+    "server": "guests",
+    "session-time-left": "23h12m5s",
+    "uptime": "47m55s",
+    "user": "guest"
+  }
+*/
+
+func DatasourceIpHotspotActive() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceIpHotspotActiveRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/ip/hotspot/active"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			"active": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"blocked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"bytes_in": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"idle_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"idle_timeout": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keepalive_timeout": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limit_bytes_in": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"limit_bytes_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"limit_bytes_total": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"login_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"packets_in": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"packets_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"radius": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"server": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session_time_left": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceIpHotspotActiveRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceIpHotspotActive().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(res, "active", s, d)
+}
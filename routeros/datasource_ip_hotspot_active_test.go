@@ -0,0 +1,60 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testDatasourceIpHotspotActive = "data.routeros_ip_hotspot_active.active"
+
+func TestAccDatasourceIpHotspotActiveTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceIpHotspotActiveConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckDatasourceIpHotspotActiveExists(testDatasourceIpHotspotActive),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccCheckDatasourceIpHotspotActiveExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDatasourceIpHotspotActiveConfig() string {
+	return `
+
+provider "routeros" {
+	insecure = true
+}
+
+data "routeros_ip_hotspot_active" "active" {}
+`
+}
@@ -0,0 +1,127 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*400001",
+    "age": "512",
+    "area": "backbone-v2",
+    "body": "options=E\n  type=network-link id=10.0.0.2 data=10.0.0.1 metric=10",
+    "checksum": "0x8D5F",
+    "dynamic": "true",
+    "flushing": "false",
+    "id": "10.255.0.1",
+    "instance": "default-v2",
+    "originator": "10.255.0.1",
+    "self-originated": "true",
+    "sequence": "0x80000004",
+    "type": "router"
+  }
+*/
+
+func DatasourceRoutingOspfLsa() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceRoutingOspfLsaRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/routing/ospf/lsa"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			"lsa": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"age": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"area": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"body": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dynamic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"flushing": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"instance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_instance_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"lsa_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The link state ID of the LSA ('id' in RouterOS).",
+						},
+						"originator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"self_originated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sequence": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceRoutingOspfLsaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceRoutingOspfLsa().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The LSA 'id' field collides with the item identifier.
+	for _, item := range *res {
+		if v, ok := item["id"]; ok {
+			item["lsa-id"] = v
+			delete(item, "id")
+		}
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(res, "lsa", s, d)
+}
@@ -0,0 +1,114 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*20000001",
+    "adjacency": "1h2m3s",
+    "address": "10.0.0.2",
+    "area": "backbone-v2",
+    "bdr": "10.0.0.1",
+    "dr": "10.0.0.2",
+    "dynamic": "true",
+    "instance": "default-v2",
+    "priority": "128",
+    "router-id": "10.255.0.2",
+    "state": "Full",
+    "state-changes": "6",
+    "timeout": "35s"
+  }
+*/
+
+func DatasourceRoutingOspfNeighbors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceRoutingOspfNeighborsRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/routing/ospf/neighbor"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			"neighbors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"adjacency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bdr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dynamic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"inactive": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"instance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"router_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_changes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timeout": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceRoutingOspfNeighborsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceRoutingOspfNeighbors().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(res, "neighbors", s, d)
+}
@@ -0,0 +1,70 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testDatasourceRoutingOspfNeighbors = "data.routeros_routing_ospf_neighbors.neighbors"
+	testDatasourceRoutingOspfLsa       = "data.routeros_routing_ospf_lsa.lsa"
+)
+
+func TestAccDatasourceRoutingOspfTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceRoutingOspfConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckDatasourceRoutingOspfExists(testDatasourceRoutingOspfNeighbors),
+							testAccCheckDatasourceRoutingOspfExists(testDatasourceRoutingOspfLsa),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccCheckDatasourceRoutingOspfExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDatasourceRoutingOspfConfig() string {
+	return `
+
+provider "routeros" {
+	insecure = true
+}
+
+data "routeros_routing_ospf_neighbors" "neighbors" {}
+
+data "routeros_routing_ospf_lsa" "lsa" {
+	filter = {
+		type = "router"
+	}
+}
+`
+}
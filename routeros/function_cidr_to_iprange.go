@@ -0,0 +1,52 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// FunctionCIDRToIpRange provider::routeros::cidr_to_iprange("10.0.0.0/23") ---> "10.0.0.0-10.0.1.255"
+type FunctionCIDRToIpRange struct{}
+
+var _ function.Function = FunctionCIDRToIpRange{}
+
+func NewFunctionCIDRToIpRange() function.Function {
+	return FunctionCIDRToIpRange{}
+}
+
+func (f FunctionCIDRToIpRange) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_to_iprange"
+}
+
+func (f FunctionCIDRToIpRange) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a CIDR prefix to an IP range.",
+		MarkdownDescription: "Converts an IPv4 or IPv6 prefix to the range of addresses in the RouterOS notation: " +
+			"`10.0.0.0/23` ---> `10.0.0.0-10.0.1.255`. The host bits of the prefix are ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The prefix in the CIDR notation.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f FunctionCIDRToIpRange) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	ip1, ip2, err := CIDRToIpRange(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, ip1+"-"+ip2)
+}
@@ -0,0 +1,56 @@
+package routeros
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// FunctionFormatDuration provider::routeros::format_duration(93600) ---> "1d2h"
+type FunctionFormatDuration struct{}
+
+var _ function.Function = FunctionFormatDuration{}
+
+func NewFunctionFormatDuration() function.Function {
+	return FunctionFormatDuration{}
+}
+
+func (f FunctionFormatDuration) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_duration"
+}
+
+func (f FunctionFormatDuration) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts seconds to a RouterOS duration.",
+		MarkdownDescription: "Converts the number of seconds to a duration in the RouterOS notation: " +
+			"`93600` ---> `1d2h`, `0.5` ---> `500ms`. Fractions of a millisecond are discarded.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:                "seconds",
+				MarkdownDescription: "The number of seconds.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f FunctionFormatDuration) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seconds *big.Float
+
+	resp.Error = req.Arguments.Get(ctx, &seconds)
+	if resp.Error != nil {
+		return
+	}
+
+	ns := new(big.Float).Mul(seconds, big.NewFloat(float64(time.Second)))
+	if ns.Cmp(big.NewFloat(math.MaxInt64)) >= 0 || ns.Cmp(big.NewFloat(math.MinInt64)) <= 0 {
+		resp.Error = function.NewArgumentFuncError(0, "the duration is out of range")
+		return
+	}
+
+	d, _ := ns.Int64()
+	resp.Error = resp.Result.Set(ctx, FormatDuration(time.Duration(d)))
+}
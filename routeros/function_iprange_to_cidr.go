@@ -0,0 +1,60 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FunctionIpRangeToCIDR provider::routeros::iprange_to_cidr("10.0.0.0-10.0.1.255") ---> ["10.0.0.0/23"]
+type FunctionIpRangeToCIDR struct{}
+
+var _ function.Function = FunctionIpRangeToCIDR{}
+
+func NewFunctionIpRangeToCIDR() function.Function {
+	return FunctionIpRangeToCIDR{}
+}
+
+func (f FunctionIpRangeToCIDR) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iprange_to_cidr"
+}
+
+func (f FunctionIpRangeToCIDR) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits an IP range into the list of CIDR prefixes.",
+		MarkdownDescription: "Splits an IPv4 or IPv6 range in the RouterOS notation into the minimal list of CIDR prefixes: " +
+			"`10.0.0.0-10.0.1.255` ---> `[\"10.0.0.0/23\"]`, `10.0.0.1-10.0.0.6` ---> " +
+			"`[\"10.0.0.1/32\", \"10.0.0.2/31\", \"10.0.0.4/31\", \"10.0.0.6/32\"]`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "range",
+				MarkdownDescription: "The range of addresses: `start-end`.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f FunctionIpRangeToCIDR) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	ip1, ip2, err := ParseIpRange(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	res, err := IpRangeToCIDRs(ip1, ip2)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, res)
+}
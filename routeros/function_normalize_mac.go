@@ -0,0 +1,52 @@
+package routeros
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// FunctionNormalizeMac provider::routeros::normalize_mac("4c5e.0c00.0001") ---> "4C:5E:0C:00:00:01"
+type FunctionNormalizeMac struct{}
+
+var _ function.Function = FunctionNormalizeMac{}
+
+func NewFunctionNormalizeMac() function.Function {
+	return FunctionNormalizeMac{}
+}
+
+func (f FunctionNormalizeMac) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_mac"
+}
+
+func (f FunctionNormalizeMac) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a MAC address to the RouterOS notation.",
+		MarkdownDescription: "Converts a MAC address to the notation used by RouterOS: " +
+			"`4c-5e-0c-00-00-01`, `4c5e.0c00.0001` ---> `4C:5E:0C:00:00:01`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "mac",
+				MarkdownDescription: "The MAC address.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f FunctionNormalizeMac) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := net.ParseMAC(s); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, NormalizeMac(s))
+}
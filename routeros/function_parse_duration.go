@@ -0,0 +1,55 @@
+package routeros
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// FunctionParseDuration provider::routeros::parse_duration("1d2h") ---> 93600
+type FunctionParseDuration struct{}
+
+var _ function.Function = FunctionParseDuration{}
+
+func NewFunctionParseDuration() function.Function {
+	return FunctionParseDuration{}
+}
+
+func (f FunctionParseDuration) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_duration"
+}
+
+func (f FunctionParseDuration) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a RouterOS duration to seconds.",
+		MarkdownDescription: "Converts a RouterOS duration (`1w2d`, `1d2h3m4s`, `500ms`, `90`) to the number of seconds. " +
+			"A number without a unit is a number of seconds.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "The duration in the RouterOS notation.",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f FunctionParseDuration) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	d, err := ParseDuration(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	seconds := new(big.Float).Quo(big.NewFloat(float64(d)), big.NewFloat(float64(time.Second)))
+	resp.Error = resp.Result.Set(ctx, seconds)
+}
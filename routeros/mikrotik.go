@@ -1,5 +1,12 @@
 package routeros
 
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
 type TransportType int
 
 // Using numbering from 1 to control type values.
@@ -13,7 +20,10 @@ type IdType int
 const (
 	Id IdType = 1 + iota
 	Name
-	// ... and maybe ssh?!
+	// CommentTag The item is identified by the provider-managed tag at the beginning of the comment:
+	// 'tf:<uuid> user comment'. The tag survives the backup restore and the hardware replacement.
+	// The tag is opt-in ('comment_tag_id' provider option), otherwise the item is identified by '.id'.
+	CommentTag
 )
 
 type ItemId struct {
@@ -27,10 +37,99 @@ func (t IdType) String() string {
 		return ".id"
 	case Name:
 		return "name"
+	case CommentTag:
+		return "comment"
 	}
 	return "error: undefined id type"
 }
 
+const commentTagPrefix = "tf:"
+
+var reCommentTag = regexp.MustCompile(`^tf:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}(?: |$)`)
+
+// newCommentTag Returns a new tag for the item: tf:<uuid>
+func newCommentTag() (string, error) {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", err
+	}
+	return commentTagPrefix + id, nil
+}
+
+// isCommentTag Returns true if the resource ID is a comment tag and not a legacy '.id'.
+func isCommentTag(id string) bool {
+	return reCommentTag.MatchString(id)
+}
+
+// splitCommentTag 'tf:<uuid> user comment' ---> 'tf:<uuid>', 'user comment'
+func splitCommentTag(comment string) (tag, userComment string) {
+	if !reCommentTag.MatchString(comment) {
+		return "", comment
+	}
+	tag, userComment, _ = strings.Cut(comment, " ")
+	return tag, userComment
+}
+
+// joinCommentTag 'tf:<uuid>', 'user comment' ---> 'tf:<uuid> user comment'
+func joinCommentTag(tag, userComment string) string {
+	if userComment == "" {
+		return tag
+	}
+	return tag + " " + userComment
+}
+
+type NestedEncodingType int
+
+// Encoding strategies for lists and sets of nested blocks.
+const (
+	// NestedJoined Each sub-field is sent as a comma-separated list of the values of all blocks:
+	// parent.field=a,b,c
+	NestedJoined NestedEncodingType = 1 + iota
+	// NestedIndexed Each block has its own set of keys with the block index:
+	// parent.0.field=a, parent.1.field=b
+	NestedIndexed
+	// NestedItems Each block is a separate item in the sub-path menu and is linked to the parent by a field:
+	// /queue/tree/add parent=<parent id> field=a
+	NestedItems
+)
+
+func (t NestedEncodingType) String() string {
+	switch t {
+	case NestedJoined:
+		return "joined"
+	case NestedIndexed:
+		return "indexed"
+	case NestedItems:
+		return "items"
+	}
+	return "error: undefined nested encoding type"
+}
+
+// NestedEncoding The encoding strategy of the nested blocks field.
+type NestedEncoding struct {
+	Type NestedEncodingType
+	Path string // NestedItems only: the sub-path menu of the items.
+	Link string // NestedItems only: the Mikrotik field of the item that contains the parent ID.
+}
+
+// ItemReference The object referenced by the field value.
+type ItemReference struct {
+	Path  string // Resource URL of the referenced object.
+	Field string // The field of the referenced object that contains the value: 'name', 'list', etc.
+	// Literal values of the field that do not refer to any object: 'none', 'static-only', etc.
+	Literals []string
+}
+
+// IsLiteral Checks whether the value is one of the literals allowed for the reference.
+func (r *ItemReference) IsLiteral(value string) bool {
+	for _, l := range r.Literals {
+		if value == l {
+			return true
+		}
+	}
+	return false
+}
+
 // MikrotikItemMetadata This information must travel from the schema to the resource polling function.
 type MikrotikItemMetadata struct {
 	IdType IdType            // The field contains ID.
@@ -56,6 +155,12 @@ func (m MikrotikItem) GetID(t IdType) string {
 		if id, ok := m["name"]; ok {
 			return id
 		}
+	case CommentTag:
+		if tag, _ := splitCommentTag(m["comment"]); tag != "" {
+			return tag
+		}
+		// The item is not tagged yet (the resource was created or imported by the '.id').
+		return m.GetID(Id)
 	default:
 		panic("[MikrotikItem.GetID] wrong IdType")
 	}
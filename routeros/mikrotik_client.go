@@ -19,9 +19,22 @@ import (
 
 type Client interface {
 	GetTransport() TransportType
+	GetOptions() *ClientOptions
+	// CheckLogin Returns false if the router rejects the credentials.
+	CheckLogin(username, password string) (bool, error)
 	SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error
 }
 
+// ClientOptions Provider settings that change the behavior of resources.
+type ClientOptions struct {
+	ValidateReferences bool   // Check the referenced objects on the router during the plan.
+	CommentTagId       bool   // Identify the items of the 'CommentTag' resources by the tag in the comment.
+	UnknownFields      string // Handling of the fields that are not in the schema: ignore, warn_once, error.
+
+	unknownFieldsReport *unknownFieldsReport
+	itemsOrder          *itemsOrderCache
+}
+
 type crudMethod int
 
 const (
@@ -33,6 +46,10 @@ const (
 	crudSign
 	crudRemove
 	crudRevoke
+	crudMove
+	crudStart
+	crudStop
+	crudMonitor
 )
 
 func NewClient(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -77,6 +94,15 @@ func NewClient(ctx context.Context, d *schema.ResourceData) (interface{}, diag.D
 		}
 	}
 
+	options := ClientOptions{
+		ValidateReferences: d.Get("validate_references").(bool),
+		CommentTagId:       d.Get("comment_tag_id").(bool),
+		UnknownFields:      d.Get("unknown_fields").(string),
+
+		unknownFieldsReport: &unknownFieldsReport{},
+		itemsOrder:          &itemsOrderCache{},
+	}
+
 	var useTLS = true
 	var transport = TransportREST
 
@@ -107,9 +133,11 @@ func NewClient(ctx context.Context, d *schema.ResourceData) (interface{}, diag.D
 			Username:  d.Get("username").(string),
 			Password:  d.Get("password").(string),
 			Transport: TransportAPI,
+			Options:   options,
 		}
 
 		if useTLS {
+			api.tlsConfig = &tlsConf
 			api.Client, err = routeros.DialTLS(api.HostURL, api.Username, api.Password, &tlsConf)
 		} else {
 			api.Client, err = routeros.Dial(api.HostURL, api.Username, api.Password)
@@ -131,6 +159,7 @@ func NewClient(ctx context.Context, d *schema.ResourceData) (interface{}, diag.D
 		Username:  d.Get("username").(string),
 		Password:  d.Get("password").(string),
 		Transport: TransportREST,
+		Options:   options,
 	}
 
 	rest.Client = &http.Client{
@@ -2,6 +2,7 @@ package routeros
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"reflect"
 	"strings"
@@ -15,19 +16,26 @@ type ApiClient struct {
 	Username  string
 	Password  string
 	Transport TransportType
+	Options   ClientOptions
 	*routeros.Client
+
+	tlsConfig *tls.Config // nil for the plain API connection.
 }
 
 var (
 	apiMethodName = map[crudMethod]string{
-		crudCreate: "/add",
-		crudRead:   "/print",
-		crudUpdate: "/set",
-		crudDelete: "/remove",
-		crudPost:   "/set",
-		crudSign:   "/sign",
-		crudRemove: "/remove",
-		crudRevoke: "/issued-revoke",
+		crudCreate:  "/add",
+		crudRead:    "/print",
+		crudUpdate:  "/set",
+		crudDelete:  "/remove",
+		crudPost:    "/set",
+		crudSign:    "/sign",
+		crudRemove:  "/remove",
+		crudRevoke:  "/issued-revoke",
+		crudMove:    "/move",
+		crudStart:   "/start",
+		crudStop:    "/stop",
+		crudMonitor: "/monitor",
 	}
 )
 
@@ -35,6 +43,31 @@ func (c *ApiClient) GetTransport() TransportType {
 	return c.Transport
 }
 
+func (c *ApiClient) GetOptions() *ClientOptions {
+	return &c.Options
+}
+
+func (c *ApiClient) CheckLogin(username, password string) (bool, error) {
+	var client *routeros.Client
+	var err error
+
+	if c.tlsConfig != nil {
+		client, err = routeros.DialTLS(c.HostURL, username, password, c.tlsConfig)
+	} else {
+		client, err = routeros.Dial(c.HostURL, username, password)
+	}
+	if err != nil {
+		// The router responded, but the login was rejected.
+		if _, ok := err.(*routeros.DeviceError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+
+	client.Close()
+	return true, nil
+}
+
 func (c *ApiClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
 
 	// https://help.mikrotik.com/docs/display/ROS/API
@@ -16,6 +16,7 @@ type RestClient struct {
 	Username  string
 	Password  string
 	Transport TransportType
+	Options   ClientOptions
 	*http.Client
 }
 
@@ -27,14 +28,18 @@ type errorResponse struct {
 
 var (
 	restMethodName = map[crudMethod]string{
-		crudCreate: "PUT",
-		crudRead:   "GET",
-		crudUpdate: "PATCH",
-		crudDelete: "DELETE",
-		crudPost:   "POST",
-		crudSign:   "POST",
-		crudRemove: "POST",
-		crudRevoke: "POST",
+		crudCreate:  "PUT",
+		crudRead:    "GET",
+		crudUpdate:  "PATCH",
+		crudDelete:  "DELETE",
+		crudPost:    "POST",
+		crudSign:    "POST",
+		crudRemove:  "POST",
+		crudRevoke:  "POST",
+		crudMove:    "POST",
+		crudStart:   "POST",
+		crudStop:    "POST",
+		crudMonitor: "POST",
 	}
 )
 
@@ -42,6 +47,32 @@ func (c *RestClient) GetTransport() TransportType {
 	return c.Transport
 }
 
+func (c *RestClient) GetOptions() *ClientOptions {
+	return &c.Options
+}
+
+func (c *RestClient) CheckLogin(username, password string) (bool, error) {
+	req, err := http.NewRequest("GET", c.HostURL+"/rest/system/identity", nil)
+	if err != nil {
+		return false, err
+	}
+	req.SetBasicAuth(username, password)
+
+	res, err := c.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch {
+	case res.StatusCode == http.StatusUnauthorized:
+		return false, nil
+	case res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest:
+		return false, fmt.Errorf("GET '%v' returned response code: %v", req.URL, res.StatusCode)
+	}
+	return true, nil
+}
+
 func (c *RestClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
 	var data io.Reader
 
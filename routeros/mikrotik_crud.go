@@ -2,6 +2,7 @@ package routeros
 
 import (
 	"fmt"
+	"sync"
 )
 
 // resource path is '/interface/vlan' etc.
@@ -22,6 +23,8 @@ func CreateItem(item MikrotikItem, resourcePath string, c Client) (MikrotikItem,
 		return nil, errEmptyPath
 	}
 
+	c.GetOptions().itemsOrder.invalidate(resourcePath)
+
 	res := MikrotikItem{}
 	err := c.SendRequest(crudCreate, &URL{Path: resourcePath}, item, &res)
 
@@ -37,6 +40,40 @@ func ReadItems(id *ItemId, resourcePath string, c Client) (*[]MikrotikItem, erro
 
 	url := &URL{Path: resourcePath}
 
+	// The resource was created or imported by the '.id' and is not tagged yet.
+	if id != nil && id.Type == CommentTag && !isCommentTag(id.Value) {
+		id = &ItemId{Id, id.Value}
+	}
+
+	// The tag is only a part of the comment, so the static items are filtered on the provider side by their
+	// comments only, and then the matching item is read by the '.id'.
+	if id != nil && id.Type == CommentTag {
+		// REST: /ip/route?.proplist=.id,comment&dynamic=false
+		// API:  /ip/route/print =.proplist=.id,comment ?dynamic=false
+		var query = []string{".proplist=.id,comment", "dynamic=false"}
+		if c.GetTransport() == TransportAPI {
+			query = []string{"=" + query[0], "?" + query[1]}
+		}
+
+		var items []MikrotikItem
+		if err := c.SendRequest(crudRead, &URL{Path: resourcePath, Query: query}, nil, &items); err != nil {
+			return &[]MikrotikItem{}, err
+		}
+
+		tag := id.Value
+		id = nil
+		for _, item := range items {
+			if t, _ := splitCommentTag(item["comment"]); t == tag {
+				id = &ItemId{Id, item.GetID(Id)}
+				break
+			}
+		}
+
+		if id == nil {
+			return &[]MikrotikItem{}, nil
+		}
+	}
+
 	// If the 'id' is nil, then this is a Datasource reading (resource Path only).
 	if id != nil {
 		// REST: prevent 404 'Not Found' error by direct resource request (/interface/vlan/*39).
@@ -53,6 +90,19 @@ func ReadItems(id *ItemId, resourcePath string, c Client) (*[]MikrotikItem, erro
 	return &res, err
 }
 
+// ReadSystemItem Reading the system resource (/system/identity, /ip/dns, etc.), which is always a single item
+// without an ID.
+func ReadSystemItem(resourcePath string, c Client) (MikrotikItem, error) {
+	if resourcePath == "" {
+		return nil, errEmptyPath
+	}
+
+	res := MikrotikItem{}
+	err := c.SendRequest(crudRead, &URL{Path: resourcePath}, nil, &res)
+
+	return res, err
+}
+
 func ReadItemsFiltered(filter []string, resourcePath string, c Client) (*[]MikrotikItem, error) {
 	if resourcePath == "" {
 		return nil, errEmptyPath
@@ -93,6 +143,189 @@ func UpdateItem(id *ItemId, resourcePath string, item MikrotikItem, c Client) (M
 	return res, err
 }
 
+// MoveItem Changing the position of the item in the menu. The item is placed before the destination item or
+// at the end of the list if the destination is empty.
+func MoveItem(id *ItemId, destination string, resourcePath string, c Client) error {
+	if id.Value == "" {
+		return errEmptyId
+	}
+	if resourcePath == "" {
+		return errEmptyPath
+	}
+
+	url := &URL{Path: resourcePath}
+
+	if c.GetTransport() == TransportREST {
+		// /ip/firewall/filter/move
+		url.Path += "/move"
+	}
+
+	c.GetOptions().itemsOrder.invalidate(resourcePath)
+
+	// {"numbers":"*54","destination":"*3"}
+	item := MikrotikItem{"numbers": id.Value}
+	if destination != "" {
+		item["destination"] = destination
+	}
+
+	return c.SendRequest(crudMove, url, item, nil)
+}
+
+// StartItem Starting the item: /container/start.
+func StartItem(id *ItemId, resourcePath string, c Client) error {
+	return itemCommand(crudStart, "/start", id, resourcePath, c)
+}
+
+// StopItem Stopping the item: /container/stop.
+func StopItem(id *ItemId, resourcePath string, c Client) error {
+	return itemCommand(crudStop, "/stop", id, resourcePath, c)
+}
+
+// MonitorItem Reading the current state of the item once: /interface/ethernet/monitor.
+func MonitorItem(id *ItemId, resourcePath string, c Client) (MikrotikItem, error) {
+	if id.Value == "" {
+		return nil, errEmptyId
+	}
+	if resourcePath == "" {
+		return nil, errEmptyPath
+	}
+
+	url := &URL{Path: resourcePath}
+
+	if c.GetTransport() == TransportREST {
+		// /interface/ethernet/monitor
+		url.Path += "/monitor"
+	}
+
+	// {"numbers":"*1","once":""}
+	var res []MikrotikItem
+	if err := c.SendRequest(crudMonitor, url, MikrotikItem{"numbers": id.Value, "once": ""}, &res); err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return MikrotikItem{}, nil
+	}
+
+	return res[0], nil
+}
+
+func itemCommand(method crudMethod, command string, id *ItemId, resourcePath string, c Client) error {
+	if id.Value == "" {
+		return errEmptyId
+	}
+	if resourcePath == "" {
+		return errEmptyPath
+	}
+
+	url := &URL{Path: resourcePath}
+
+	if c.GetTransport() == TransportREST {
+		// /container/start
+		url.Path += command
+	}
+
+	// {".id":"*1"}
+	return c.SendRequest(method, url, MikrotikItem{".id": id.Value}, nil)
+}
+
+// ReadItemsOrder Returns the IDs of all items of the menu in the order in which they are processed by the router.
+func ReadItemsOrder(resourcePath string, c Client) ([]string, error) {
+	if resourcePath == "" {
+		return nil, errEmptyPath
+	}
+
+	// REST: /ip/firewall/filter?.proplist=.id
+	// API:  /ip/firewall/filter/print =.proplist=.id
+	var query = ".proplist=.id"
+	if c.GetTransport() == TransportAPI {
+		query = "=" + query
+	}
+
+	var items []MikrotikItem
+	if err := c.SendRequest(crudRead, &URL{Path: resourcePath, Query: []string{query}}, nil, &items); err != nil {
+		return nil, err
+	}
+
+	var res = make([]string, 0, len(items))
+	for _, item := range items {
+		res = append(res, item.GetID(Id))
+	}
+
+	return res, nil
+}
+
+// itemsOrderCache The order of the items read during the refresh, it is shared by all resources of the menu.
+// The order of the menu is dropped when an item of the menu is created, moved or deleted.
+type itemsOrderCache struct {
+	sync.Mutex
+	order map[string][]string
+}
+
+func (o *itemsOrderCache) invalidate(resourcePath string) {
+	if o == nil {
+		return
+	}
+
+	o.Lock()
+	defer o.Unlock()
+	delete(o.order, resourcePath)
+}
+
+// ReadItemsOrderCached Returns the order of the items of the menu, it is only read once until the menu is changed.
+func ReadItemsOrderCached(resourcePath string, c Client) ([]string, error) {
+	o := c.GetOptions().itemsOrder
+	if o == nil {
+		return ReadItemsOrder(resourcePath, c)
+	}
+
+	// The lock is held while reading, so the concurrent reads of the same menu wait for the first one.
+	o.Lock()
+	defer o.Unlock()
+
+	if order, ok := o.order[resourcePath]; ok {
+		return order, nil
+	}
+
+	order, err := ReadItemsOrder(resourcePath, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.order == nil {
+		o.order = make(map[string][]string)
+	}
+	o.order[resourcePath] = order
+
+	return order, nil
+}
+
+// ReadItemsField Returns the values of the field of all items of the menu, the other fields are not read.
+func ReadItemsField(field, resourcePath string, c Client) ([]string, error) {
+	if resourcePath == "" {
+		return nil, errEmptyPath
+	}
+
+	// REST: /interface?.proplist=name
+	// API:  /interface/print =.proplist=name
+	var query = ".proplist=" + field
+	if c.GetTransport() == TransportAPI {
+		query = "=" + query
+	}
+
+	var items []MikrotikItem
+	if err := c.SendRequest(crudRead, &URL{Path: resourcePath, Query: []string{query}}, nil, &items); err != nil {
+		return nil, err
+	}
+
+	var res = make([]string, 0, len(items))
+	for _, item := range items {
+		res = append(res, item[field])
+	}
+
+	return res, nil
+}
+
 func DeleteItem(id *ItemId, resourcePath string, c Client) error {
 	if id.Value == "" {
 		return errEmptyId
@@ -101,6 +334,8 @@ func DeleteItem(id *ItemId, resourcePath string, c Client) error {
 		return errEmptyPath
 	}
 
+	c.GetOptions().itemsOrder.invalidate(resourcePath)
+
 	url := &URL{Path: resourcePath}
 
 	if c.GetTransport() == TransportREST {
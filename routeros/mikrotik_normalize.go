@@ -0,0 +1,202 @@
+package routeros
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Normalizer Converts the equivalent representations of a field value into a single canonical form.
+// The normalizers are declared in the 'MetaNormalize' field of the schema and are applied in both directions
+// of serialization and when comparing the old and new values of the field during the plan.
+type Normalizer struct {
+	// Normalize Returns the canonical form of the value. Values that cannot be parsed are returned as is.
+	Normalize func(v string) string
+	// ToMikrotik Optional post-processing of the item field before sending it to Mikrotik.
+	ToMikrotik func(item MikrotikItem, mikrotikKebabName string)
+	// FromMikrotik Optional pre-processing of the item field received from Mikrotik.
+	FromMikrotik func(item MikrotikItem, mikrotikKebabName string)
+}
+
+// Stock normalizers.
+var normalizers = map[string]*Normalizer{
+	// 1d, 24h, 86400 ---> 1d
+	"duration": {Normalize: NormalizeDuration},
+	// 0x8000, 32768 ---> 0x8000
+	"hex": {Normalize: NormalizeHex},
+	// 4c:5e:0c:00:00:01 ---> 4C:5E:0C:00:00:01
+	"mac": {Normalize: NormalizeMac},
+	// 2001:0db8::0001/128 ---> 2001:db8::1, 10.0.0.1/32 ---> 10.0.0.1
+	"ip": {Normalize: NormalizeIp},
+	// 10000000/10000k ---> 10M/10M
+	"rate": {Normalize: NormalizeRate},
+	// c,a,b ---> a,b,c
+	"list": {Normalize: NormalizeUnorderedList},
+	// true, yes ---> yes
+	"bool": {Normalize: BoolToMikrotikJSONStr},
+	// A RouterOS flag: the presence of the field in the item means 'yes', the absence means 'no'.
+	"flag": {
+		Normalize: BoolToMikrotikJSONStr,
+		ToMikrotik: func(item MikrotikItem, name string) {
+			if v, ok := item[name]; ok {
+				if v == "no" {
+					delete(item, name)
+				} else {
+					item[name] = ""
+				}
+			}
+		},
+		FromMikrotik: func(item MikrotikItem, name string) {
+			if _, ok := item[name]; ok {
+				item[name] = "yes"
+			} else {
+				item[name] = "no"
+			}
+		},
+	},
+}
+
+// loadNormalizers Converting the metadata of the 'MetaNormalize' field into a map of normalizers.
+// s: `"cache_max_ttl":"duration","mac_address":"mac"` in the TF (snake) notation!
+func loadNormalizers(s string) (m map[string]*Normalizer) {
+	m = make(map[string]*Normalizer)
+	for _, b := range reTransformSet.FindAllStringSubmatch(s, -1) {
+		n, ok := normalizers[b[2]]
+		if !ok {
+			panic("[loadNormalizers] wrong normalizer: " + b[2])
+		}
+		m[b[1]] = n
+	}
+	return
+}
+
+// getNormalizers Returns the normalizers declared in the schema.
+func getNormalizers(s map[string]*schema.Schema) map[string]*Normalizer {
+	if n, ok := s[MetaNormalize]; ok {
+		return loadNormalizers(n.Default.(string))
+	}
+	return nil
+}
+
+// normalizedEqual Comparison of the old and new values of the field in the canonical form.
+func normalizedEqual(n *Normalizer, suppress schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if suppress != nil && suppress(k, old, new, d) {
+			return true
+		}
+
+		if old == new {
+			return true
+		}
+
+		if old == "" || new == "" {
+			return false
+		}
+
+		return n.Normalize(old) == n.Normalize(new)
+	}
+}
+
+// applyNormalizers Setting the comparison of values in the canonical form for the fields with declared normalizers.
+func applyNormalizers(s map[string]*schema.Schema) {
+	for name, n := range getNormalizers(s) {
+		field, ok := s[name]
+		if !ok {
+			panic("[applyNormalizers] the normalizer is declared for a non-existent field: " + name)
+		}
+		field.DiffSuppressFunc = normalizedEqual(n, field.DiffSuppressFunc)
+	}
+}
+
+// NormalizeDuration 86400, 24h, 1d ---> 1d; 90s ---> 1m30s
+func NormalizeDuration(v string) string {
+	d, err := ParseDuration(v)
+	if err != nil {
+		// The value is not a duration: 'disabled', 'none', etc.
+		return v
+	}
+
+	return FormatDuration(d)
+}
+
+// NormalizeHex 32768, 0x8000 ---> 0x8000
+func NormalizeHex(v string) string {
+	i, err := strconv.ParseInt(v, 0, 64)
+	if err != nil {
+		return v
+	}
+	return fmt.Sprintf("0x%x", i)
+}
+
+// NormalizeMac 4c-5e-0c-00-00-01, 4c5e.0c00.0001 ---> 4C:5E:0C:00:00:01
+func NormalizeMac(v string) string {
+	mac, err := net.ParseMAC(v)
+	if err != nil {
+		return v
+	}
+	return strings.ToUpper(mac.String())
+}
+
+// NormalizeIp Normalization of the addresses, prefixes and ranges, including comma-separated lists and negation:
+// 2001:0DB8::0001/128 ---> 2001:db8::1; !10.0.0.1/32 ---> !10.0.0.1
+func NormalizeIp(v string) string {
+	if v == "" {
+		return v
+	}
+
+	values := strings.Split(v, ",")
+	for i, value := range values {
+		value = strings.TrimSpace(value)
+
+		var neg string
+		if strings.HasPrefix(value, "!") {
+			neg, value = "!", value[1:]
+		}
+
+		// 10.0.0.1-10.0.0.10
+		if r := strings.SplitN(value, "-", 2); len(r) == 2 {
+			values[i] = neg + normalizeAddress(r[0]) + "-" + normalizeAddress(r[1])
+			continue
+		}
+
+		values[i] = neg + normalizeAddress(value)
+	}
+
+	return strings.Join(values, ",")
+}
+
+func normalizeAddress(v string) string {
+	if p, err := netip.ParsePrefix(v); err == nil {
+		// The host prefix is shown by RouterOS as the address.
+		if p.IsSingleIP() {
+			return p.Addr().String()
+		}
+		return p.String()
+	}
+
+	if a, err := netip.ParseAddr(v); err == nil {
+		return a.String()
+	}
+
+	return v
+}
+
+// NormalizeUnorderedList c, a,b ---> a,b,c
+func NormalizeUnorderedList(v string) string {
+	if v == "" {
+		return v
+	}
+
+	values := strings.Split(v, ",")
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	sort.Strings(values)
+
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,131 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestStockNormalizers(t *testing.T) {
+	tests := []struct {
+		normalizer string
+		in         string
+		want       string
+	}{
+		{"duration", "1d", "1d"},
+		{"duration", "24h", "1d"},
+		{"duration", "86400", "1d"},
+		{"duration", "90s", "1m30s"},
+		{"duration", "1w2d", "1w2d"},
+		{"duration", "500ms", "500ms"},
+		{"duration", "0", "0s"},
+		{"duration", "disabled", "disabled"},
+		{"hex", "32768", "0x8000"},
+		{"hex", "0x8000", "0x8000"},
+		{"hex", "0X8000", "0x8000"},
+		{"mac", "4c:5e:0c:00:00:01", "4C:5E:0C:00:00:01"},
+		{"mac", "4c-5e-0c-00-00-01", "4C:5E:0C:00:00:01"},
+		{"mac", "", ""},
+		{"ip", "10.0.0.1/32", "10.0.0.1"},
+		{"ip", "10.0.0.0/24", "10.0.0.0/24"},
+		{"ip", "!10.0.0.1/32", "!10.0.0.1"},
+		{"ip", "2001:0DB8:0000::0001/128", "2001:db8::1"},
+		{"ip", "2001:0db8::/32", "2001:db8::/32"},
+		{"ip", "10.0.0.1-10.0.0.010", "10.0.0.1-10.0.0.010"},
+		{"ip", "10.0.0.1/32,2001:db8:0::1", "10.0.0.1,2001:db8::1"},
+		{"rate", "10000000/10000k", "10M/10M"},
+		{"rate", "1.5M", "1500k"},
+		{"rate", "0/0", "0/0"},
+		{"rate", "unlimited", "unlimited"},
+		{"list", "related, established", "established,related"},
+		{"list", "", ""},
+		{"bool", "true", "yes"},
+		{"bool", "no", "no"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.normalizer+":"+tt.in, func(t *testing.T) {
+			if got := normalizers[tt.normalizer].Normalize(tt.in); got != tt.want {
+				t.Errorf("%v normalizer: got %q, want %q", tt.normalizer, got, tt.want)
+			}
+		})
+	}
+}
+
+var testNormalizeResource = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/test/resource"),
+		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"timeout":"duration","fib":"flag"`),
+
+		"timeout": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"fib": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	},
+}
+
+func Test_normalizersRoundTrip(t *testing.T) {
+	s := testNormalizeResource.Schema
+	applyNormalizers(s)
+
+	if !s["timeout"].DiffSuppressFunc("timeout", "1d", "24h", nil) {
+		t.Error("equivalent durations must not produce a diff")
+	}
+	if s["timeout"].DiffSuppressFunc("timeout", "1d", "12h", nil) {
+		t.Error("different durations must produce a diff")
+	}
+
+	// The absence of the flag means 'no'.
+	item := MikrotikItem{".id": "*1", "timeout": "1d"}
+	d := testNormalizeResource.Data(nil)
+	d.Set("timeout", "24h")
+	if diags := MikrotikResourceDataToTerraform(item, s, d); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if _, ok := item["fib"]; ok {
+		t.Error("the incoming item must not be changed")
+	}
+	if d.Get("fib").(bool) {
+		t.Error("fib: expected false")
+	}
+	// The equivalent value is kept.
+	if d.Get("timeout").(string) != "24h" {
+		t.Errorf("timeout: expected '24h', got '%v'", d.Get("timeout"))
+	}
+
+	// The presence of the flag means 'yes'.
+	d = testNormalizeResource.Data(nil)
+	if diags := MikrotikResourceDataToTerraform(MikrotikItem{"fib": "", "timeout": "1h"}, s, d); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !d.Get("fib").(bool) {
+		t.Error("fib: expected true")
+	}
+	if d.Get("timeout").(string) != "1h" {
+		t.Errorf("timeout: expected '1h', got '%v'", d.Get("timeout"))
+	}
+
+	// The canonical form is sent, the enabled flag is sent without a value.
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{"timeout": "24h", "fib": true})
+
+	actual, _ := TerraformResourceDataToMikrotik(s, d)
+	if actual["timeout"] != "1d" {
+		t.Errorf("timeout: expected '1d', got '%v'", actual["timeout"])
+	}
+	if v, ok := actual["fib"]; !ok || v != "" {
+		t.Errorf("fib: expected an empty value, got '%v'", v)
+	}
+
+	// The disabled flag is not sent at all.
+	item = MikrotikItem{"fib": "no"}
+	normalizers["flag"].ToMikrotik(item, "fib")
+	if _, ok := item["fib"]; ok {
+		t.Errorf("fib: the flag must not be sent, got '%v'", item["fib"])
+	}
+}
@@ -0,0 +1,106 @@
+package routeros
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// secretChanged The value of a secret field in the state when the secret on the router no longer matches
+// the last value sent. It differs from any configured value, so the field is planned for update.
+const secretChanged = "(changed outside of Terraform)"
+
+// getSecrets Returns the write-only fields declared in the schema.
+func getSecrets(s map[string]*schema.Schema) map[string]struct{} {
+	if sf, ok := s[MetaSecrets]; ok {
+		return loadSkipFields(sf.Default.(string))
+	}
+	return nil
+}
+
+// secretHash Returns the salted hash of the value: <salt>:<sha256(salt + value)>
+func secretHash(value string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(append(salt, value...))
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(h[:]), nil
+}
+
+// secretMatches Comparison of the value with the salted hash.
+func secretMatches(value, hash string) bool {
+	f := strings.SplitN(hash, ":", 2)
+	if len(f) != 2 {
+		return false
+	}
+	salt, err := hex.DecodeString(f[0])
+	if err != nil {
+		return false
+	}
+	h := sha256.Sum256(append(salt, value...))
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(h[:])), []byte(f[1])) == 1
+}
+
+// isMaskedSecret RouterOS replaces the secret values with asterisks for users without the 'sensitive' policy.
+func isMaskedSecret(v string) bool {
+	return v != "" && strings.Trim(v, "*") == ""
+}
+
+// updateSecretHashes Storing the hashes of the secret values sent to the router.
+// The hash is kept while the value is unchanged, so the state does not change on every update.
+func updateSecretHashes(s map[string]*schema.Schema, d *schema.ResourceData) error {
+	secrets := getSecrets(s)
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	hashes := make(map[string]interface{})
+	old, _ := d.Get(KeySecretHashes).(map[string]interface{})
+
+	for name := range secrets {
+		value := d.Get(name).(string)
+		if h, ok := old[name].(string); ok && secretMatches(value, h) {
+			hashes[name] = h
+			continue
+		}
+
+		h, err := secretHash(value)
+		if err != nil {
+			return err
+		}
+		hashes[name] = h
+	}
+
+	return d.Set(KeySecretHashes, hashes)
+}
+
+// secretsFromMikrotik Pre-processing of the secret fields received from Mikrotik.
+// Masked values and values that match the last sent one are removed from the item, so the state keeps
+// the configured value. A value that no longer matches is stored in the state and the field is planned for update.
+func secretsFromMikrotik(item MikrotikItem, secrets map[string]struct{}, d *schema.ResourceData) {
+	hashes, _ := d.Get(KeySecretHashes).(map[string]interface{})
+
+	for name := range secrets {
+		kebabName := SnakeToKebab(name)
+
+		v, ok := item[kebabName]
+		if !ok {
+			continue
+		}
+
+		if isMaskedSecret(v) {
+			delete(item, kebabName)
+			continue
+		}
+
+		// There is no hash after the import, the router value is used as is.
+		if h, ok := hashes[name].(string); ok && secretMatches(v, h) {
+			delete(item, kebabName)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testSecretsResource = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/test/resource"),
+		MetaId:           PropId(Id),
+		MetaSecrets:      PropSecrets(`"password"`),
+
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		KeySecretHashes: PropSecretHashesRo,
+	},
+}
+
+func Test_secretHash(t *testing.T) {
+	h1, err := secretHash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, _ := secretHash("secret")
+	if h1 == h2 {
+		t.Error("the hashes of the same value must be salted")
+	}
+	if !secretMatches("secret", h1) || !secretMatches("secret", h2) {
+		t.Error("the value must match its hash")
+	}
+	if secretMatches("other", h1) || secretMatches("secret", "wrong") {
+		t.Error("the value must not match")
+	}
+
+	if !isMaskedSecret("*****") || isMaskedSecret("") || isMaskedSecret("*a*") {
+		t.Error("wrong detection of the masked value")
+	}
+}
+
+func Test_secretsDrift(t *testing.T) {
+	s := testSecretsResource.Schema
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"password": "secret"})
+
+	if err := updateSecretHashes(s, d); err != nil {
+		t.Fatal(err)
+	}
+	hash := d.Get(KeySecretHashes).(map[string]interface{})["password"].(string)
+
+	// The hash is kept while the value is unchanged.
+	if err := updateSecretHashes(s, d); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get(KeySecretHashes).(map[string]interface{})["password"] != hash {
+		t.Error("the hash of the unchanged value must be kept")
+	}
+
+	for _, tt := range []struct {
+		name   string
+		item   MikrotikItem
+		expect string
+	}{
+		{"not returned", MikrotikItem{}, "secret"},
+		{"masked", MikrotikItem{"password": "******"}, "secret"},
+		{"unchanged", MikrotikItem{"password": "secret"}, "secret"},
+		{"changed on the router", MikrotikItem{"password": "changed"}, "changed"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d.Set("password", "secret")
+			if diags := MikrotikResourceDataToTerraform(tt.item, s, d); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if d.Get("password") != tt.expect {
+				t.Errorf("password: expected '%v', got '%v'", tt.expect, d.Get("password"))
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package routeros
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -97,6 +98,232 @@ func loadSkipFields(s string) (m map[string]struct{}) {
 	return
 }
 
+// loadNestedBlocks Converting the metadata of the 'MetaNestedBlocks' field into a map of encoding strategies.
+// s: `"networks":"joined","options":"indexed","children":"items:/queue/tree:parent"` in the TF (snake) notation!
+func loadNestedBlocks(s string) (m map[string]*NestedEncoding) {
+	m = make(map[string]*NestedEncoding)
+	for _, b := range reTransformSet.FindAllStringSubmatch(s, -1) {
+		f := strings.SplitN(b[2], ":", 3)
+		switch f[0] {
+		case "joined":
+			m[b[1]] = &NestedEncoding{Type: NestedJoined}
+		case "indexed":
+			m[b[1]] = &NestedEncoding{Type: NestedIndexed}
+		case "items":
+			if len(f) != 3 {
+				panic("[loadNestedBlocks] the sub-path and the link field must be defined for the field: " + b[1])
+			}
+			m[b[1]] = &NestedEncoding{Type: NestedItems, Path: f[1], Link: f[2]}
+		default:
+			panic("[loadNestedBlocks] wrong encoding type: " + b[2])
+		}
+	}
+	return
+}
+
+// loadReferences Converting the metadata of the 'MetaReferences' field into a map of referenced objects.
+// s: `"interface":"/interface","list":"/ip/firewall/address-list:list","pool":"/ip/pool|none"` in the TF (snake) notation!
+func loadReferences(s string) (m map[string]*ItemReference) {
+	m = make(map[string]*ItemReference)
+	for _, b := range reTransformSet.FindAllStringSubmatch(s, -1) {
+		l := strings.Split(b[2], "|")
+		f := strings.SplitN(l[0], ":", 2)
+		m[b[1]] = &ItemReference{Path: f[0], Field: KeyName}
+		if len(f) == 2 {
+			m[b[1]].Field = f[1]
+		}
+		if len(l) > 1 {
+			m[b[1]].Literals = l[1:]
+		}
+	}
+	return
+}
+
+// GetReferences Returns the references to other objects declared in the schema.
+func GetReferences(s map[string]*schema.Schema) map[string]*ItemReference {
+	if rs, ok := s[MetaReferences]; ok {
+		return loadReferences(rs.Default.(string))
+	}
+	return nil
+}
+
+// getNestedBlocks Returns the encoding strategies declared in the schema.
+func getNestedBlocks(s map[string]*schema.Schema) map[string]*NestedEncoding {
+	if nb, ok := s[MetaNestedBlocks]; ok {
+		m := loadNestedBlocks(nb.Default.(string))
+		checkNestedBlocks(s, m)
+		return m
+	}
+	return nil
+}
+
+// checkNestedBlocks Checking the schema of the nested blocks against the declared encoding.
+// The values of all blocks are joined with commas, so the fields of the joined blocks can not be lists
+// or sets: ["a,b","c"] and ["a","b,c"] would be encoded in the same way.
+func checkNestedBlocks(s map[string]*schema.Schema, m map[string]*NestedEncoding) {
+	for name, enc := range m {
+		field, ok := s[name]
+		if !ok {
+			panic("[checkNestedBlocks] the nested blocks field is not declared in the schema: " + name)
+		}
+
+		elem, ok := field.Elem.(*schema.Resource)
+		if !ok {
+			panic("[checkNestedBlocks] the nested blocks field is not a list or set of blocks: " + name)
+		}
+
+		// The items are managed by their IDs, which are stored in the state.
+		if enc.Type == NestedItems {
+			if id, ok := elem.Schema["id"]; !ok || id.Type != schema.TypeString || !id.Computed {
+				panic("[checkNestedBlocks] the items encoding requires the computed string 'id' sub-field: " + name)
+			}
+		}
+
+		if enc.Type != NestedJoined {
+			continue
+		}
+
+		for fieldName, fieldSchema := range elem.Schema {
+			if fieldSchema.Type == schema.TypeList || fieldSchema.Type == schema.TypeSet {
+				panic(fmt.Sprintf("[checkNestedBlocks] the joined encoding does not support the list and set "+
+					"fields, use the indexed encoding for: %v.%v", name, fieldName))
+			}
+		}
+	}
+}
+
+// checkNestedJoinedValues The values of the joined blocks can not contain commas, otherwise the values
+// are assigned to the wrong blocks when they are read back.
+func checkNestedJoinedValues(name string, blocks []interface{}) error {
+	for i, block := range blocks {
+		b, ok := block.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for fieldName, v := range b {
+			if s, ok := v.(string); ok && strings.Contains(s, ",") {
+				return fmt.Errorf("%v.%v.%v: the value '%v' can not contain commas", name, i, fieldName, s)
+			}
+		}
+	}
+	return nil
+}
+
+// nestedFieldNames Returns the sorted list of writable fields of the nested block.
+func nestedFieldNames(s map[string]*schema.Schema) []string {
+	var res []string
+	for name, field := range s {
+		if reMetadataFields.MatchString(name) || field.Computed && !field.Optional {
+			continue
+		}
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// nestedValueToMikrotik Converting the value of the nested block field to the Mikrotik notation.
+func nestedValueToMikrotik(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return BoolToMikrotikJSON(v)
+	case []interface{}:
+		return ListToString(v)
+	case *schema.Set:
+		return ListToString(v.List())
+	}
+	return fmt.Sprint(v)
+}
+
+// nestedValueFromMikrotik Converting the Mikrotik value to the type of the nested block field.
+func nestedValueFromMikrotik(s *schema.Schema, v string) (interface{}, error) {
+	switch s.Type {
+	case schema.TypeString:
+		return v, nil
+	case schema.TypeInt:
+		return strconv.Atoi(v)
+	case schema.TypeBool:
+		return BoolFromMikrotikJSON(v), nil
+	case schema.TypeList, schema.TypeSet:
+		var l []interface{}
+		if v == "" {
+			return l, nil
+		}
+		for _, e := range strings.Split(v, ",") {
+			if es, ok := s.Elem.(*schema.Schema); ok && es.Type == schema.TypeInt {
+				i, err := strconv.Atoi(e)
+				if err != nil {
+					return nil, err
+				}
+				l = append(l, i)
+				continue
+			}
+			l = append(l, e)
+		}
+		return l, nil
+	}
+	return nil, fmt.Errorf("nested field type not implemented: %v", s.Type)
+}
+
+// nestedBlocksToMikrotik Marshal the list of nested blocks using the joined or indexed encoding.
+// The number of blocks in the old state is used to clear the keys of the removed blocks.
+func nestedBlocksToMikrotik(item MikrotikItem, mikrotikKebabName string, s map[string]*schema.Schema,
+	blocks []interface{}, oldLen int, enc *NestedEncoding, transformSet map[string]string) {
+
+	setItem := func(k, v string) {
+		k = SnakeToKebab(k)
+		// Field transformation: "networks.address" ---> "networks".
+		if new, ok := transformSet[k]; ok {
+			k = new
+		}
+		item[k] = v
+	}
+
+	fields := nestedFieldNames(s)
+
+	switch enc.Type {
+	case NestedJoined:
+		// parent.field=a,b,c
+		for _, fieldName := range fields {
+			var values []interface{}
+			var isEmpty = true
+
+			for _, block := range blocks {
+				v := nestedValueToMikrotik(block.(map[string]interface{})[fieldName])
+				isEmpty = isEmpty && v == ""
+				values = append(values, v)
+			}
+
+			// Skip fields that are not used in any block, but clear them if the blocks have been removed.
+			if isEmpty && s[fieldName].Optional && len(blocks) > 0 {
+				continue
+			}
+
+			setItem(mikrotikKebabName+"."+fieldName, ListToString(values))
+		}
+	case NestedIndexed:
+		// parent.0.field=a, parent.1.field=b
+		for i, block := range blocks {
+			for _, fieldName := range fields {
+				setItem(fmt.Sprintf("%v.%v.%v", mikrotikKebabName, i, fieldName),
+					nestedValueToMikrotik(block.(map[string]interface{})[fieldName]))
+			}
+		}
+		// Clear the keys of the removed blocks.
+		for i := len(blocks); i < oldLen; i++ {
+			for _, fieldName := range fields {
+				setItem(fmt.Sprintf("%v.%v.%v", mikrotikKebabName, i, fieldName), "")
+			}
+		}
+	}
+}
+
 // ListToString Convert List and Set to a delimited string.
 func ListToString(v any) (res string) {
 	for i, elem := range v.([]interface{}) {
@@ -116,6 +343,8 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 	rawConfig := d.GetRawConfig()
 	var transformSet map[string]string
 	var skipFields map[string]struct{}
+	var nestedBlocks = getNestedBlocks(s)
+	var normalizers = getNormalizers(s)
 
 	// {"channel.config": "channel", "schema-field-name": "mikrotik-field-name"}
 	if ts, ok := s[MetaTransformSet]; ok {
@@ -136,7 +365,7 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 				meta.IdType = IdType(terraformMetadata.Default.(int))
 			case MetaResourcePath:
 				meta.Path = terraformMetadata.Default.(string)
-			case MetaTransformSet, MetaSkipFields:
+			case MetaTransformSet, MetaSkipFields, MetaNestedBlocks, MetaReferences, MetaNormalize, MetaSecrets:
 				continue
 			default:
 				meta.Meta[terraformSnakeName] = terraformMetadata.Default.(string)
@@ -149,6 +378,11 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 			continue
 		}
 
+		// The provider behavior fields.
+		if terraformSnakeName == KeyResetOnDestroy || terraformSnakeName == KeyVerifySecret {
+			continue
+		}
+
 		// Skip all read-only properties.
 		if terraformMetadata.Computed && !terraformMetadata.Optional {
 			continue
@@ -189,6 +423,31 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 		mikrotikKebabName := SnakeToKebab(terraformSnakeName)
 		value := d.Get(terraformSnakeName)
 
+		// Lists and sets of nested blocks with the declared encoding.
+		if enc, ok := nestedBlocks[terraformSnakeName]; ok {
+			// Items of the sub-path menu are processed separately (see TerraformNestedItems).
+			if enc.Type == NestedItems {
+				continue
+			}
+
+			var blocks, oldBlocks []interface{}
+			old, _ := d.GetChange(terraformSnakeName)
+
+			switch terraformMetadata.Type {
+			case schema.TypeList:
+				blocks, oldBlocks = value.([]interface{}), old.([]interface{})
+			case schema.TypeSet:
+				blocks, oldBlocks = value.(*schema.Set).List(), old.(*schema.Set).List()
+			default:
+				panic(fmt.Sprintf("[TerraformResourceDataToMikrotik] nested blocks encoding is declared for the "+
+					"wrong field type: %v for '%v'", terraformMetadata.Type, terraformSnakeName))
+			}
+
+			nestedBlocksToMikrotik(item, mikrotikKebabName, terraformMetadata.Elem.(*schema.Resource).Schema,
+				blocks, len(oldBlocks), enc, transformSet)
+			continue
+		}
+
 		switch terraformMetadata.Type {
 		case schema.TypeString:
 			item[mikrotikKebabName] = value.(string)
@@ -259,6 +518,15 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 				terraformMetadata.Type, terraformSnakeName))
 		}
 
+		// Sending the value in the canonical form.
+		if n, ok := normalizers[terraformSnakeName]; ok {
+			if v, ok := item[mikrotikKebabName]; ok {
+				item[mikrotikKebabName] = n.Normalize(v)
+			}
+			if n.ToMikrotik != nil {
+				n.ToMikrotik(item, mikrotikKebabName)
+			}
+		}
 	}
 
 	return item, meta
@@ -269,6 +537,40 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 	var diags diag.Diagnostics
 	var err error
 	var transformSet map[string]string
+	var nestedBlocks = getNestedBlocks(s)
+	var normalizers = getNormalizers(s)
+	var secrets = getSecrets(s)
+
+	// Pre-processing of the fields with declared normalizers and secrets, the incoming item is not changed.
+	var copied bool
+	var copyItem = func() {
+		if !copied {
+			res := make(MikrotikItem, len(item))
+			for k, v := range item {
+				res[k] = v
+			}
+			item, copied = res, true
+		}
+	}
+	for name, n := range normalizers {
+		if n.FromMikrotik == nil {
+			continue
+		}
+
+		copyItem()
+		n.FromMikrotik(item, SnakeToKebab(name))
+	}
+	if len(secrets) > 0 {
+		copyItem()
+		secretsFromMikrotik(item, secrets, d)
+	}
+	// The provider-managed tag is not a part of the comment in the TF schema.
+	if id, ok := s[MetaId]; ok && IdType(id.Default.(int)) == CommentTag {
+		if comment, ok := item["comment"]; ok {
+			copyItem()
+			_, item["comment"] = splitCommentTag(comment)
+		}
+	}
 
 	// {"channel": "channel.config", "mikrotik-field-name": "schema-field-name"}
 	if ts, ok := s[MetaTransformSet]; ok {
@@ -278,6 +580,8 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 	// TypeMap,TypeSet initialization information storage.
 	var maps = make(map[string]map[string]interface{})
 	var nestedLists = make(map[string]map[string]interface{})
+	// Lists and sets of nested blocks with the declared encoding: field name -> block index -> block.
+	var nestedBlockLists = make(map[string]map[int]map[string]interface{})
 
 	// Incoming map iteration.
 	for mikrotikKebabName, mikrotikValue := range item {
@@ -315,20 +619,80 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 		if _, ok := s[terraformSnakeName]; !ok {
 			// For development.
 			// panic("[MikrotikResourceDataToTerraform] The field was lost during the Schema development: " + terraformSnakeName)
-			diags = append(diags, diag.Diagnostic{
-				// TODO Waiting for TestStep.ExpectWarning https://github.com/hashicorp/terraform-plugin-testing/pull/17
-				// The test response to Warnings has not yet been implemented.
-				Severity: diag.Warning,
-				Summary:  "Field '" + terraformSnakeName + "' not found in the schema",
-				Detail: fmt.Sprintf("[MikrotikResourceDataToTerraform] The field was lost during the Schema development: ▷ '%s': '%s' ◁",
-					terraformSnakeName, mikrotikValue),
-			})
+			diags = append(diags, unknownFieldDiagnostic(terraformSnakeName,
+				fmt.Sprintf("[MikrotikResourceDataToTerraform] The field was lost during the Schema development: ▷ '%s': '%s' ◁",
+					terraformSnakeName, mikrotikValue)))
 			// Catch all fields.
 			continue
 		}
 
+		// Lists and sets of nested blocks with the declared encoding.
+		if enc, ok := nestedBlocks[terraformSnakeName]; ok && enc.Type != NestedItems {
+			blocks, ok := nestedBlockLists[terraformSnakeName]
+			if !ok {
+				blocks = make(map[int]map[string]interface{})
+				nestedBlockLists[terraformSnakeName] = blocks
+			}
+
+			var values = map[int]string{}
+
+			switch enc.Type {
+			case NestedJoined:
+				// networks.address: "10.0.0.0/24,10.0.1.0/24"
+				if mikrotikValue != "" {
+					for i, v := range strings.Split(mikrotikValue, ",") {
+						values[i] = v
+					}
+				}
+			case NestedIndexed:
+				// networks.0.address: "10.0.0.0/24"
+				f := strings.SplitN(subFieldSnakeName, ".", 2)
+				i, e := strconv.Atoi(f[0])
+				if e != nil || len(f) != 2 {
+					diags = append(diags, diag.Errorf("wrong indexed field format: '%v.%v'",
+						terraformSnakeName, subFieldSnakeName)...)
+					continue
+				}
+				// Keys of the removed blocks.
+				if mikrotikValue != "" {
+					values[i] = mikrotikValue
+				}
+				subFieldSnakeName = f[1]
+			}
+
+			fieldSchema, ok := s[terraformSnakeName].Elem.(*schema.Resource).Schema[subFieldSnakeName]
+			if !ok {
+				diags = append(diags, unknownFieldDiagnostic(terraformSnakeName+"."+subFieldSnakeName,
+					fmt.Sprintf("[MikrotikResourceDataToTerraform] the Schema sub-field was lost during development: ▷ '%s.%s' ◁",
+						terraformSnakeName, subFieldSnakeName)))
+				continue
+			}
+
+			for i, v := range values {
+				value, e := nestedValueFromMikrotik(fieldSchema, v)
+				if e != nil {
+					diags = append(diags, diag.Errorf("%v for '%v.%v.%v' field", e, terraformSnakeName, i, subFieldSnakeName)...)
+					continue
+				}
+
+				if _, ok := blocks[i]; !ok {
+					blocks[i] = map[string]interface{}{}
+				}
+				blocks[i][subFieldSnakeName] = value
+			}
+			continue
+		}
+
 		switch s[terraformSnakeName].Type {
 		case schema.TypeString:
+			if n, ok := normalizers[terraformSnakeName]; ok {
+				mikrotikValue = n.Normalize(mikrotikValue)
+
+				// Keep the equivalent value of the configuration or state.
+				if n.Normalize(d.Get(terraformSnakeName).(string)) == mikrotikValue {
+					break
+				}
+			}
 			err = d.Set(terraformSnakeName, mikrotikValue)
 
 		case schema.TypeInt:
@@ -384,12 +748,9 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 					var v any
 
 					if _, ok := s[terraformSnakeName].Elem.(*schema.Resource).Schema[subFieldSnakeName]; !ok {
-						diags = append(diags, diag.Diagnostic{
-							Severity: diag.Warning,
-							Summary:  "Field '" + terraformSnakeName + "." + subFieldSnakeName + "' not found in the schema",
-							Detail: fmt.Sprintf("[MikrotikResourceDataToTerraformDatasource] the datasource Schema sub-field was lost during development: ▷ '%s.%s' ◁",
-								terraformSnakeName, subFieldSnakeName),
-						})
+						diags = append(diags, unknownFieldDiagnostic(terraformSnakeName+"."+subFieldSnakeName,
+							fmt.Sprintf("[MikrotikResourceDataToTerraformDatasource] the datasource Schema sub-field was lost during development: ▷ '%s.%s' ◁",
+								terraformSnakeName, subFieldSnakeName)))
 						continue
 					}
 
@@ -454,6 +815,12 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
+	// Nested blocks processing.
+	for name, blocks := range nestedBlockLists {
+		if err = d.Set(name, nestedBlocksToList(blocks)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
 	// Maps processing.
 	for name, m := range maps {
 		if err = d.Set(name, m); err != nil {
@@ -464,6 +831,112 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 	return diags
 }
 
+// nestedBlocksToList Converting the indexed blocks into an ordered list.
+func nestedBlocksToList(blocks map[int]map[string]interface{}) []interface{} {
+	var indexes []int
+	for i := range blocks {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	var res = make([]interface{}, 0, len(blocks))
+	for _, i := range indexes {
+		res = append(res, blocks[i])
+	}
+	return res
+}
+
+// TerraformNestedItems Marshal the nested blocks that are stored as separate items in the sub-path menu.
+// The link field is not filled in, because the parent ID is only known after the parent has been created.
+func TerraformNestedItems(s map[string]*schema.Schema, d *schema.ResourceData) map[string][]MikrotikItem {
+	var res = make(map[string][]MikrotikItem)
+
+	for terraformSnakeName, enc := range getNestedBlocks(s) {
+		if enc.Type != NestedItems {
+			continue
+		}
+
+		blocks := nestedBlocksList(d.Get(terraformSnakeName))
+		fields := nestedFieldNames(s[terraformSnakeName].Elem.(*schema.Resource).Schema)
+		items := make([]MikrotikItem, 0, len(blocks))
+
+		for _, block := range blocks {
+			item := MikrotikItem{}
+			for _, fieldName := range fields {
+				v := nestedValueToMikrotik(block.(map[string]interface{})[fieldName])
+				if v == "" {
+					continue
+				}
+				item[SnakeToKebab(fieldName)] = v
+			}
+			items = append(items, item)
+		}
+
+		res[terraformSnakeName] = items
+	}
+
+	return res
+}
+
+// MikrotikNestedItemsToTerraform Unmarshal the items of the sub-path menu into the nested blocks.
+func MikrotikNestedItemsToTerraform(items []MikrotikItem, terraformSnakeName string, s map[string]*schema.Schema,
+	d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	enc, ok := getNestedBlocks(s)[terraformSnakeName]
+	if !ok || enc.Type != NestedItems {
+		return diag.Errorf("[MikrotikNestedItemsToTerraform] the field '%v' is not declared as the sub-path items",
+			terraformSnakeName)
+	}
+
+	fields := s[terraformSnakeName].Elem.(*schema.Resource).Schema
+	var blocks = make([]interface{}, 0, len(items))
+
+	for _, item := range items {
+		block := map[string]interface{}{}
+
+		for mikrotikKebabName, mikrotikValue := range item {
+			if mikrotikKebabName == ".id" {
+				block["id"] = mikrotikValue
+				continue
+			}
+
+			// Skip all service fields and the link to the parent.
+			if mikrotikKebabName[0:1] == "." || mikrotikKebabName == "ret" || mikrotikKebabName == enc.Link {
+				continue
+			}
+
+			fieldName := KebabToSnake(mikrotikKebabName)
+			fieldSchema, ok := fields[fieldName]
+			// The dynamic items are not managed, so the flag is only stored if it is declared in the schema.
+			if !ok && fieldName == KeyDynamic {
+				continue
+			}
+			if !ok {
+				diags = append(diags, unknownFieldDiagnostic(terraformSnakeName+"."+fieldName,
+					fmt.Sprintf("[MikrotikNestedItemsToTerraform] the Schema sub-field was lost during development: ▷ '%s.%s': '%s' ◁",
+						terraformSnakeName, fieldName, mikrotikValue)))
+				continue
+			}
+
+			v, err := nestedValueFromMikrotik(fieldSchema, mikrotikValue)
+			if err != nil {
+				diags = append(diags, diag.Errorf("%v for '%v.%v' field", err, terraformSnakeName, fieldName)...)
+				continue
+			}
+			block[fieldName] = v
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	if err := d.Set(terraformSnakeName, blocks); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
 func MikrotikResourceDataToTerraformDatasource(items *[]MikrotikItem, resourceDataKeyName string, s map[string]*schema.Schema, d *schema.ResourceData) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var dsItems []map[string]interface{}
@@ -473,12 +946,9 @@ func MikrotikResourceDataToTerraformDatasource(items *[]MikrotikItem, resourceDa
 	if !ok {
 		// For development.
 		//panic("[MikrotikResourceDataToTerraformDatasource] the datasource Schema field was lost during development: " + resourceDataKeyName)
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Field '" + resourceDataKeyName + "' not found in the schema",
-			Detail: fmt.Sprintf("[MikrotikResourceDataToTerraformDatasource] the datasource Schema field was lost during development: ▷ '%s' ◁",
-				resourceDataKeyName),
-		})
+		diags = append(diags, unknownFieldDiagnostic(resourceDataKeyName,
+			fmt.Sprintf("[MikrotikResourceDataToTerraformDatasource] the datasource Schema field was lost during development: ▷ '%s' ◁",
+				resourceDataKeyName)))
 		// Or panic.
 		return diags
 	}
@@ -507,12 +977,9 @@ func MikrotikResourceDataToTerraformDatasource(items *[]MikrotikItem, resourceDa
 			if _, ok := s[terraformSnakeName]; !ok {
 				// For development.
 				//panic("[MikrotikResourceDataToTerraformDatasource] the field was lost during development.: " + terraformSnakeName)
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Warning,
-					Summary:  "Field '" + terraformSnakeName + "' not found in the schema",
-					Detail: fmt.Sprintf("[MikrotikResourceDataToTerraformDatasource] the field was lost during the Schema development: ▷ '%s': '%s' ◁",
-						terraformSnakeName, mikrotikValue),
-				})
+				diags = append(diags, unknownFieldDiagnostic(terraformSnakeName,
+					fmt.Sprintf("[MikrotikResourceDataToTerraformDatasource] the field was lost during the Schema development: ▷ '%s': '%s' ◁",
+						terraformSnakeName, mikrotikValue)))
 				// Catch all fields.
 				continue
 			}
@@ -0,0 +1,185 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// frameworkValues Returns the attribute values of the object, the null object has no values.
+func frameworkValues(v tftypes.Value) (map[string]tftypes.Value, error) {
+	values := map[string]tftypes.Value{}
+	if v.IsNull() || !v.IsKnown() {
+		return values, nil
+	}
+	err := v.As(&values)
+	return values, err
+}
+
+// FrameworkResourceDataToMikrotik Marshal Mikrotik resource from the plan of the framework resource.
+// The prior state is used to clear the values removed from the configuration, it is null during the creation.
+func FrameworkResourceDataToMikrotik(ctx context.Context, s fwschema.Schema, plan, state tftypes.Value) (MikrotikItem, *MikrotikItemMetadata, error) {
+	item := MikrotikItem{}
+	meta := FwGetMetadata(ctx, s)
+
+	planValues, err := frameworkValues(plan)
+	if err != nil {
+		return nil, nil, err
+	}
+	stateValues, err := frameworkValues(state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for terraformSnakeName, a := range s.Attributes {
+		// Metadata, ID and read-only attributes.
+		if reMetadataFields.MatchString(terraformSnakeName) || terraformSnakeName == "id" ||
+			(a.IsComputed() && !a.IsOptional() && !a.IsRequired()) {
+			continue
+		}
+
+		mikrotikKebabName := SnakeToKebab(terraformSnakeName)
+		value := planValues[terraformSnakeName]
+
+		if !value.IsKnown() {
+			continue
+		}
+
+		if value.IsNull() {
+			// The value has been removed from the configuration.
+			if old, ok := stateValues[terraformSnakeName]; ok && !old.IsNull() && old.Type().Is(tftypes.String) {
+				item[mikrotikKebabName] = ""
+			}
+			continue
+		}
+
+		switch {
+		case value.Type().Is(tftypes.String):
+			var v string
+			err = value.As(&v)
+			item[mikrotikKebabName] = v
+		case value.Type().Is(tftypes.Bool):
+			var v bool
+			err = value.As(&v)
+			item[mikrotikKebabName] = BoolToMikrotikJSON(v)
+		case value.Type().Is(tftypes.Number):
+			var v big.Float
+			err = value.As(&v)
+			item[mikrotikKebabName] = v.Text('f', -1)
+		case value.Type().Is(tftypes.List{ElementType: tftypes.String}), value.Type().Is(tftypes.Set{ElementType: tftypes.String}):
+			var elems []tftypes.Value
+			err = value.As(&elems)
+
+			var list []string
+			for _, e := range elems {
+				var v string
+				if err = e.As(&v); err != nil {
+					break
+				}
+				list = append(list, v)
+			}
+			item[mikrotikKebabName] = strings.Join(list, ",")
+		default:
+			panic(fmt.Sprintf("[FrameworkResourceDataToMikrotik] attribute type not implemented: %v for '%v'",
+				value.Type(), terraformSnakeName))
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("attribute '%v': %w", terraformSnakeName, err)
+		}
+	}
+
+	return item, meta, nil
+}
+
+// MikrotikResourceDataToFramework Unmarshal Mikrotik resource to the state of the framework resource.
+// Attributes that are not returned by Mikrotik keep the prior (planned) value, unknown values become null.
+func MikrotikResourceDataToFramework(ctx context.Context, item MikrotikItem, s fwschema.Schema, prior tftypes.Value, id string) (tftypes.Value, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+
+	objectType := s.Type().TerraformType(ctx)
+	priorValues, err := frameworkValues(prior)
+	if err != nil {
+		diags.AddError("State conversion", err.Error())
+		return tftypes.NewValue(objectType, nil), diags
+	}
+
+	// Fields that are not in the schema.
+	var unknown []string
+	for mikrotikKebabName := range item {
+		if mikrotikKebabName[0:1] == "." || mikrotikKebabName == "ret" {
+			continue
+		}
+		if _, ok := s.Attributes[KebabToSnake(mikrotikKebabName)]; !ok {
+			unknown = append(unknown, KebabToSnake(mikrotikKebabName))
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		diags.AddAttributeWarning(unknownFieldFwPath(name), unknownFieldSummary(name),
+			fmt.Sprintf("[MikrotikResourceDataToFramework] The field was lost during the Schema development: ▷ '%s': '%s' ◁",
+				name, item[SnakeToKebab(name)]))
+	}
+
+	values := make(map[string]tftypes.Value, len(s.Attributes))
+	for terraformSnakeName, a := range s.Attributes {
+		typ := a.GetType().TerraformType(ctx)
+		mikrotikValue, ok := item[SnakeToKebab(terraformSnakeName)]
+
+		switch {
+		case terraformSnakeName == "id":
+			values[terraformSnakeName] = tftypes.NewValue(typ, id)
+			continue
+		case reMetadataFields.MatchString(terraformSnakeName) || !ok:
+			if v, ok := priorValues[terraformSnakeName]; ok && v.IsKnown() {
+				values[terraformSnakeName] = v
+			} else {
+				values[terraformSnakeName] = tftypes.NewValue(typ, nil)
+			}
+			continue
+		}
+
+		v, err := frameworkValueFromMikrotik(typ, mikrotikValue)
+		if err != nil {
+			diags.AddError("State conversion", fmt.Sprintf("attribute '%v': %v", terraformSnakeName, err))
+			continue
+		}
+		values[terraformSnakeName] = v
+	}
+
+	return tftypes.NewValue(objectType, values), diags
+}
+
+func frameworkValueFromMikrotik(typ tftypes.Type, v string) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, v), nil
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, BoolFromMikrotikJSON(v)), nil
+	case typ.Is(tftypes.Number):
+		if v == "" {
+			return tftypes.NewValue(typ, nil), nil
+		}
+		i, err := strconv.ParseInt(v, 0, 64)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(typ, new(big.Float).SetInt64(i)), nil
+	case typ.Is(tftypes.List{ElementType: tftypes.String}), typ.Is(tftypes.Set{ElementType: tftypes.String}):
+		elems := []tftypes.Value{}
+		if v != "" {
+			for _, e := range strings.Split(v, ",") {
+				elems = append(elems, tftypes.NewValue(tftypes.String, e))
+			}
+		}
+		return tftypes.NewValue(typ, elems), nil
+	}
+	return tftypes.Value{}, fmt.Errorf("attribute type not implemented: %v", typ)
+}
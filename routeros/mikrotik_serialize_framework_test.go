@@ -0,0 +1,138 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testFrameworkSchema = fwschema.Schema{
+	Attributes: map[string]fwschema.Attribute{
+		MetaResourcePath: FwPropResourcePath("/ip/pool"),
+		MetaId:           FwPropId(Name),
+		"id":             FwPropIdRo,
+		"name":           fwschema.StringAttribute{Required: true},
+		"comment":        fwschema.StringAttribute{Optional: true},
+		"disabled":       fwschema.BoolAttribute{Optional: true},
+		"mtu":            fwschema.Int64Attribute{Optional: true, Computed: true},
+		"ranges":         fwschema.ListAttribute{ElementType: types.StringType, Optional: true},
+		"running":        fwschema.BoolAttribute{Computed: true},
+	},
+}
+
+func testFrameworkValue(t *testing.T, values map[string]interface{}) tftypes.Value {
+	t.Helper()
+
+	objectType := testFrameworkSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+	res := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		res[name] = tftypes.NewValue(typ, values[name])
+	}
+	return tftypes.NewValue(objectType, res)
+}
+
+func TestFrameworkResourceDataToMikrotik(t *testing.T) {
+	ctx := context.Background()
+
+	if meta := FwGetMetadata(ctx, testFrameworkSchema); meta.Path != "/ip/pool" || meta.IdType != Name {
+		t.Fatalf("unexpected metadata: %v", meta)
+	}
+
+	state := testFrameworkValue(t, map[string]interface{}{
+		"name":    "pool",
+		"comment": "old comment",
+	})
+	plan := testFrameworkValue(t, map[string]interface{}{
+		"name":     "pool",
+		"disabled": true,
+		"mtu":      tftypes.UnknownValue,
+		"ranges": []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "10.0.0.1-10.0.0.10"),
+			tftypes.NewValue(tftypes.String, "10.0.1.0/24"),
+		},
+	})
+
+	item, _, err := FrameworkResourceDataToMikrotik(ctx, testFrameworkSchema, plan, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := MikrotikItem{
+		"name":     "pool",
+		"comment":  "",
+		"disabled": BoolToMikrotikJSON(true),
+		"ranges":   "10.0.0.1-10.0.0.10,10.0.1.0/24",
+	}
+	if len(item) != len(expected) {
+		t.Fatalf("unexpected item: %v", item)
+	}
+	for k, v := range expected {
+		if item[k] != v {
+			t.Errorf("field '%v': expected %q, got %q", k, v, item[k])
+		}
+	}
+}
+
+func TestMikrotikResourceDataToFramework(t *testing.T) {
+	ctx := context.Background()
+
+	prior := testFrameworkValue(t, map[string]interface{}{
+		MetaResourcePath: "/ip/pool",
+		"name":           "pool",
+		"comment":        "comment",
+		"mtu":            tftypes.UnknownValue,
+	})
+	item := MikrotikItem{
+		".id":       "*1",
+		"name":      "pool",
+		"disabled":  "false",
+		"mtu":       "1500",
+		"ranges":    "10.0.0.1-10.0.0.10",
+		"running":   "true",
+		"new-field": "value",
+	}
+
+	value, diags := MikrotikResourceDataToFramework(ctx, item, testFrameworkSchema, prior, "pool")
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("the unknown field must be reported: %v", diags)
+	}
+	if name, ok := unknownFieldFwName(diags[0]); !ok || name != "new_field" {
+		t.Errorf("unexpected unknown field %q: %v", name, diags)
+	}
+
+	values, err := frameworkValues(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var s string
+	var b bool
+	if _ = values["id"].As(&s); s != "pool" {
+		t.Errorf("unexpected id: %v", values["id"])
+	}
+	if _ = values[MetaResourcePath].As(&s); s != "/ip/pool" {
+		t.Errorf("the metadata must be kept: %v", values[MetaResourcePath])
+	}
+	if _ = values["comment"].As(&s); s != "comment" {
+		t.Errorf("the missing field must keep the prior value: %v", values["comment"])
+	}
+	if _ = values["running"].As(&b); !b {
+		t.Errorf("unexpected running: %v", values["running"])
+	}
+	if !values[MetaId].IsNull() {
+		t.Errorf("the unknown value must become null: %v", values[MetaId])
+	}
+	if !values["mtu"].Equal(tftypes.NewValue(tftypes.Number, 1500)) {
+		t.Errorf("unexpected mtu: %v", values["mtu"])
+	}
+	if !values["ranges"].Equal(tftypes.NewValue(tftypes.List{ElementType: tftypes.String},
+		[]tftypes.Value{tftypes.NewValue(tftypes.String, "10.0.0.1-10.0.0.10")})) {
+		t.Errorf("unexpected ranges: %v", values["ranges"])
+	}
+}
@@ -1,6 +1,7 @@
 package routeros
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -142,3 +143,334 @@ func Test_loadTransformSet(t *testing.T) {
 		}
 	}
 }
+
+var testNestedBlocksResource = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/test/resource"),
+		MetaId:           PropId(Name),
+		MetaNestedBlocks: PropNestedBlocks(`"joined":"joined","indexed":"indexed","set":"indexed",
+			"items":"items:/test/resource/item:parent"`),
+		"name": {
+			Type: schema.TypeString,
+		},
+		"joined": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"cost": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"indexed": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"code": {
+						Type: schema.TypeInt,
+					},
+					"value": {
+						Type: schema.TypeString,
+					},
+					"force": {
+						Type: schema.TypeBool,
+					},
+				},
+			},
+		},
+		"set": {
+			Type: schema.TypeSet,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+		"items": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type: schema.TypeString,
+					},
+					"max_limit": {
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	},
+}
+
+func Test_loadNestedBlocks(t *testing.T) {
+	expected := map[string]*NestedEncoding{
+		"joined":  {Type: NestedJoined},
+		"indexed": {Type: NestedIndexed},
+		"set":     {Type: NestedIndexed},
+		"items":   {Type: NestedItems, Path: "/test/resource/item", Link: "parent"},
+	}
+
+	actual := loadNestedBlocks(testNestedBlocksResource.Schema[MetaNestedBlocks].Default.(string))
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, actual)
+	}
+}
+
+func Test_loadReferences(t *testing.T) {
+	expected := map[string]*ItemReference{
+		"interface":        {Path: "/interface", Field: "name"},
+		"in_interface":     {Path: "/interface", Field: "name"},
+		"src_address_list": {Path: "/ip/firewall/address-list", Field: "list"},
+		"address_pool":     {Path: "/ip/pool", Field: "name", Literals: []string{"static-only", "none"}},
+		"dst_address_list": {Path: "/ip/firewall/address-list", Field: "list", Literals: []string{"none"}},
+	}
+
+	actual := loadReferences(`"interface":"/interface","in_interface":"/interface",
+		"src_address_list":"/ip/firewall/address-list:list","address_pool":"/ip/pool|static-only|none",
+		"dst_address_list":"/ip/firewall/address-list:list|none"`)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, actual)
+	}
+}
+
+func Test_itemReferenceIsLiteral(t *testing.T) {
+	ref := loadReferences(`"address_pool":"/ip/pool|static-only"`)["address_pool"]
+
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"static-only", true},
+		{"none", false},
+		{"pool1", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := ref.IsLiteral(tt.value); got != tt.want {
+				t.Errorf("IsLiteral(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_nestedBlocksRoundTrip(t *testing.T) {
+	joined := []interface{}{
+		map[string]interface{}{"address": "10.0.0.0/24", "cost": 10},
+		map[string]interface{}{"address": "10.0.1.0/24", "cost": 20},
+	}
+	indexed := []interface{}{
+		map[string]interface{}{"code": 42, "value": "'pool.ntp.org'", "force": true},
+		map[string]interface{}{"code": 66, "value": "s'192.168.88.1'", "force": false},
+	}
+	set := []interface{}{
+		map[string]interface{}{"value": "first"},
+		map[string]interface{}{"value": "second"},
+	}
+
+	expected := MikrotikItem{
+		"name":            "test",
+		"joined.address":  "10.0.0.0/24,10.0.1.0/24",
+		"joined.cost":     "10,20",
+		"indexed.0.code":  "42",
+		"indexed.0.value": "'pool.ntp.org'",
+		"indexed.0.force": "yes",
+		"indexed.1.code":  "66",
+		"indexed.1.value": "s'192.168.88.1'",
+		"indexed.1.force": "no",
+		"set.0.value":     "",
+		"set.1.value":     "",
+	}
+
+	testResourceData := testNestedBlocksResource.TestResourceData()
+	testResourceData.SetId("test")
+	testResourceData.Set("name", "test")
+	testResourceData.Set("joined", joined)
+	testResourceData.Set("indexed", indexed)
+	testResourceData.Set("set", set)
+
+	actual, _ := TerraformResourceDataToMikrotik(testNestedBlocksResource.Schema, testResourceData)
+
+	// The order of the set elements depends on the hash function.
+	for _, key := range []string{"set.0.value", "set.1.value"} {
+		if actual[key] != "first" && actual[key] != "second" {
+			t.Fatalf("bad: (key: %v) unexpected set value: %#v", key, actual[key])
+		}
+		expected[key] = actual[key]
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, actual)
+	}
+
+	// Reverse conversion into the empty resource.
+	roundTripData := testNestedBlocksResource.TestResourceData()
+	if diags := MikrotikResourceDataToTerraform(actual, testNestedBlocksResource.Schema, roundTripData); diags.HasError() {
+		t.Fatalf("decoding err: %v", diags)
+	}
+
+	for key, expected := range map[string]interface{}{"joined": joined, "indexed": indexed} {
+		if actual := roundTripData.Get(key); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("bad: (key: %v) expected:%#v\nactual:%#v", key, expected, actual)
+		}
+	}
+
+	if !roundTripData.Get("set").(*schema.Set).Equal(testResourceData.Get("set")) {
+		t.Fatalf("bad: (key: set) expected:%#v\nactual:%#v", testResourceData.Get("set").(*schema.Set).List(),
+			roundTripData.Get("set").(*schema.Set).List())
+	}
+}
+
+func Test_nestedBlocksListFields(t *testing.T) {
+	blockSchema := func(enc string) map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/test/resource"),
+			MetaId:           PropId(Name),
+			MetaNestedBlocks: PropNestedBlocks(`"blocks":"` + enc + `"`),
+			"blocks": {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"values": {
+							Type: schema.TypeList,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// ["a,b","c"] and ["a","b,c"] can not be distinguished in the joined encoding.
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("the joined encoding of the list fields must be rejected")
+			}
+		}()
+		getNestedBlocks(blockSchema("joined"))
+	}()
+
+	// The indexed encoding keeps the values of each block separately.
+	r := schema.Resource{Schema: blockSchema("indexed")}
+	blocks := []interface{}{
+		map[string]interface{}{"values": []interface{}{"a", "b"}},
+		map[string]interface{}{"values": []interface{}{"c"}},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("test")
+	d.Set("blocks", blocks)
+
+	item, _ := TerraformResourceDataToMikrotik(r.Schema, d)
+	expected := MikrotikItem{"blocks.0.values": "a,b", "blocks.1.values": "c"}
+	if !reflect.DeepEqual(item, expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, item)
+	}
+
+	roundTripData := r.TestResourceData()
+	if diags := MikrotikResourceDataToTerraform(item, r.Schema, roundTripData); diags.HasError() {
+		t.Fatalf("decoding err: %v", diags)
+	}
+	if actual := roundTripData.Get("blocks"); !reflect.DeepEqual(actual, blocks) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", blocks, actual)
+	}
+}
+
+func Test_checkNestedJoinedValues(t *testing.T) {
+	if err := checkNestedJoinedValues("joined", []interface{}{
+		map[string]interface{}{"address": "10.0.0.0/24", "cost": 10},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := checkNestedJoinedValues("joined", []interface{}{
+		map[string]interface{}{"address": "10.0.0.0/24,10.0.1.0/24", "cost": 10},
+	}); err == nil {
+		t.Fatal("the values with commas must be rejected")
+	}
+}
+
+// All nested blocks declared in the provider resources must match their encoding.
+func Test_providerNestedBlocks(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					t.Errorf("%v: %v", name, err)
+				}
+			}()
+			getNestedBlocks(r.Schema)
+		}()
+	}
+}
+
+func Test_nestedItemsRoundTrip(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"name": "download", "max_limit": "10M"},
+		map[string]interface{}{"name": "upload", "max_limit": "5M"},
+	}
+
+	expected := []MikrotikItem{
+		{"name": "download", "max-limit": "10M"},
+		{"name": "upload", "max-limit": "5M"},
+	}
+
+	testResourceData := testNestedBlocksResource.TestResourceData()
+	testResourceData.SetId("test")
+	testResourceData.Set("items", items)
+
+	actual := TerraformNestedItems(testNestedBlocksResource.Schema, testResourceData)
+	if !reflect.DeepEqual(actual["items"], expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, actual["items"])
+	}
+
+	// The router returns items with service fields and the link to the parent.
+	for i := range actual["items"] {
+		actual["items"][i][".id"] = fmt.Sprintf("*%v", i+1)
+		actual["items"][i]["parent"] = "test"
+	}
+
+	roundTripData := testNestedBlocksResource.TestResourceData()
+	if diags := MikrotikNestedItemsToTerraform(actual["items"], "items", testNestedBlocksResource.Schema,
+		roundTripData); diags.HasError() {
+		t.Fatalf("decoding err: %v", diags)
+	}
+
+	// The IDs of the items are recorded in the state.
+	items[0].(map[string]interface{})["id"] = "*1"
+	items[1].(map[string]interface{})["id"] = "*2"
+	if actual := roundTripData.Get("items"); !reflect.DeepEqual(actual, items) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", items, actual)
+	}
+}
+
+func Test_commentTagFromMikrotik(t *testing.T) {
+	r := ResourceIPAddress()
+	tag, _ := newCommentTag()
+
+	d := r.Data(nil)
+	item := MikrotikItem{".id": "*1", "address": "10.0.0.1/24", "comment": tag + " uplink"}
+	if diags := MikrotikResourceDataToTerraform(item, r.Schema, d); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if d.Get(KeyComment) != "uplink" {
+		t.Errorf("comment: expected 'uplink', got '%v'", d.Get(KeyComment))
+	}
+	if item["comment"] != tag+" uplink" {
+		t.Error("the incoming item must not be changed")
+	}
+}
@@ -83,6 +83,41 @@ func TestMikrotikItem_GetID(t *testing.T) {
 	}
 }
 
+func Test_commentTag(t *testing.T) {
+	tag, err := newCommentTag()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isCommentTag(tag) || isCommentTag("*1A") || isCommentTag("tf:not-a-uuid") {
+		t.Errorf("wrong detection of the tag: %v", tag)
+	}
+
+	tests := []struct {
+		comment     string
+		tag         string
+		userComment string
+	}{
+		{joinCommentTag(tag, ""), tag, ""},
+		{joinCommentTag(tag, "uplink"), tag, "uplink"},
+		{joinCommentTag(tag, "two words"), tag, "two words"},
+		{"uplink", "", "uplink"},
+		{tag + "uplink", "", tag + "uplink"},
+	}
+	for _, tt := range tests {
+		if gotTag, gotComment := splitCommentTag(tt.comment); gotTag != tt.tag || gotComment != tt.userComment {
+			t.Errorf("splitCommentTag(%q) = %q, %q, want %q, %q", tt.comment, gotTag, gotComment, tt.tag, tt.userComment)
+		}
+	}
+
+	if got := (MikrotikItem{".id": "*1A", "comment": tag + " uplink"}).GetID(CommentTag); got != tag {
+		t.Errorf("GetID() = %v, want %v", got, tag)
+	}
+	// The item is not tagged yet.
+	if got := (MikrotikItem{".id": "*1A", "comment": "uplink"}).GetID(CommentTag); got != "*1A" {
+		t.Errorf("GetID() = %v, want *1A", got)
+	}
+}
+
 func Test_kebabToSnake(t *testing.T) {
 	type args struct {
 		name string
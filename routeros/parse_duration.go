@@ -3,6 +3,8 @@ package routeros
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -108,6 +110,43 @@ func ParseDuration(s string) (time.Duration, error) {
 	return time.Duration(d), nil
 }
 
+// FormatDuration Formatting the duration in the RouterOS notation: 90s ---> 1m30s; 24h ---> 1d
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var res strings.Builder
+	if d <= -time.Millisecond {
+		res.WriteString("-")
+		d = -d
+	}
+
+	for _, u := range []struct {
+		name string
+		d    time.Duration
+	}{
+		{"w", time.Hour * 168},
+		{"d", time.Hour * 24},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+		{"ms", time.Millisecond},
+	} {
+		if n := d / u.d; n > 0 {
+			res.WriteString(strconv.FormatInt(int64(n), 10) + u.name)
+			d -= n * u.d
+		}
+	}
+
+	// Less than a millisecond.
+	if res.Len() == 0 {
+		return "0s"
+	}
+
+	return res.String()
+}
+
 func quote(s string) string {
 	return "\"" + s + "\""
 }
@@ -36,3 +36,25 @@ func TestParseDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{300 * time.Millisecond, "300ms"},
+		{90 * time.Second, "1m30s"},
+		{26 * time.Hour, "1d2h"},
+		{98960400 * time.Second, "163w4d9h"},
+		{-90 * time.Second, "-1m30s"},
+		{time.Microsecond, "0s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatDuration(tt.d); got != tt.want {
+				t.Errorf("FormatDuration() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,55 +1,112 @@
 package routeros
 
 import (
-	"encoding/binary"
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
+	"net/netip"
 	"strings"
 )
 
 // https://gist.github.com/vaerh/65b30353239cd17ee14f2e68983fbbf0
 
-var pow = []int{24, 16, 8, 0}
+func parseIpRange(ip1, ip2 string) (netip.Addr, netip.Addr, error) {
+	a1, err := netip.ParseAddr(ip1)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, err
+	}
+	a2, err := netip.ParseAddr(ip2)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, err
+	}
+
+	a1, a2 = a1.Unmap(), a2.Unmap()
+	if a1.Is4() != a2.Is4() {
+		return netip.Addr{}, netip.Addr{}, errors.New("start and end must be of the same address family")
+	}
+	if a2.Less(a1) {
+		return netip.Addr{}, netip.Addr{}, errors.New("start must be less than end")
+	}
+
+	return a1, a2, nil
+}
+
+// IpRangeToCIDRs Splitting the IPv4 or IPv6 range into the minimal list of prefixes:
+// 10.0.0.1-10.0.0.6 ---> 10.0.0.1/32, 10.0.0.2/31, 10.0.0.4/31, 10.0.0.6/32
+func IpRangeToCIDRs(ip1, ip2 string) ([]string, error) {
+	start, end, err := parseIpRange(ip1, ip2)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for {
+		// The largest prefix that starts at 'start' and does not go beyond 'end'.
+		bits := start.BitLen()
+		for bits > 0 {
+			p := netip.PrefixFrom(start, bits-1).Masked()
+			if p.Addr() != start || end.Less(lastAddr(p)) {
+				break
+			}
+			bits--
+		}
 
-func ipToULong(ip string) (res uint32) {
-	var oct uint64
+		p := netip.PrefixFrom(start, bits)
+		res = append(res, p.String())
 
-	for i, v := range strings.SplitN(ip, ".", 4) {
-		oct, _ = strconv.ParseUint(v, 10, 32)
-		res |= uint32(oct << pow[i])
+		last := lastAddr(p)
+		if last == end {
+			return res, nil
+		}
+		start = last.Next()
 	}
-	return
 }
 
+// IpRangeToCIDR Returns the prefix if the range is solid, otherwise the range itself:
+// 192.168.0.0-192.168.1.255 ---> 192.168.0.0/23; 192.168.0.0-192.168.1.254 ---> 192.168.0.0-192.168.1.254
 func IpRangeToCIDR(ip1, ip2 string) (string, error) {
-	a1 := ipToULong(ip1)
-	a2 := ipToULong(ip2)
+	res, err := IpRangeToCIDRs(ip1, ip2)
+	if err != nil {
+		return "", err
+	}
 
-	if a1 > a2 {
-		return "", errors.New("start must be less than end")
+	if len(res) == 1 {
+		return res[0], nil
 	}
 
-	var m uint32 = 0xFFFFFFFF
-	l := 32
+	return ip1 + "-" + ip2, nil
+}
 
-	for m > 0 {
-		m1 := m << 1
-		if (a1&m1) != a1 || (a1|^m1) > a2 {
-			break
-		}
-		m = m1
-		l--
+// CIDRToIpRange Returns the first and the last addresses of the prefix: 10.0.0.0/24 ---> 10.0.0.0, 10.0.0.255
+func CIDRToIpRange(cidr string) (string, string, error) {
+	p, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", "", err
 	}
+	p = p.Masked()
 
-	var addr = make([]byte, 4)
-	binary.BigEndian.PutUint32(addr, a1)
-
-	a1 |= ^m
+	return p.Addr().String(), lastAddr(p).String(), nil
+}
 
-	if a2 < a1+1 {
-		return fmt.Sprintf("%d.%d.%d.%d/%v", addr[0], addr[1], addr[2], addr[3], l), nil
+// ParseIpRange Splitting the range in the RouterOS notation: 10.0.0.1-10.0.0.10 ---> 10.0.0.1, 10.0.0.10
+func ParseIpRange(s string) (string, string, error) {
+	ips := strings.Split(s, "-")
+	if len(ips) != 2 {
+		return "", "", fmt.Errorf("invalid IP range: %q", s)
 	}
 
-	return ip1 + "-" + ip2, nil
+	return strings.TrimSpace(ips[0]), strings.TrimSpace(ips[1]), nil
+}
+
+// lastAddr Returns the last address of the prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	a := p.Masked().Addr()
+	b := new(big.Int).SetBytes(a.AsSlice())
+	hostBits := uint(a.BitLen() - p.Bits())
+	b.Or(b, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), hostBits), big.NewInt(1)))
+
+	buf := make([]byte, a.BitLen()/8)
+	b.FillBytes(buf)
+	res, _ := netip.AddrFromSlice(buf)
+	return res
 }
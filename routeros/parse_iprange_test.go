@@ -1,6 +1,9 @@
 package routeros
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestIpRangeToCIDR(t *testing.T) {
 	type args struct {
@@ -16,6 +19,11 @@ func TestIpRangeToCIDR(t *testing.T) {
 		{"Solid range", args{"192.168.0.0", "192.168.1.255"}, "192.168.0.0/23", false},
 		{"Non-solid range", args{"192.168.0.0", "192.168.1.254"}, "192.168.0.0-192.168.1.254", false},
 		{"Wrong range", args{"192.168.2.0", "192.168.1.255"}, "", true},
+		{"Single address", args{"192.168.0.1", "192.168.0.1"}, "192.168.0.1/32", false},
+		{"IPv6 solid range", args{"2001:db8::", "2001:db8::ffff"}, "2001:db8::/112", false},
+		{"IPv6 non-solid range", args{"2001:db8::1", "2001:db8::ffff"}, "2001:db8::1-2001:db8::ffff", false},
+		{"Mixed families", args{"192.168.0.0", "2001:db8::ffff"}, "", true},
+		{"Invalid address", args{"192.168.0", "192.168.1.255"}, "", true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -30,3 +38,58 @@ func TestIpRangeToCIDR(t *testing.T) {
 		})
 	}
 }
+
+func TestIpRangeToCIDRs(t *testing.T) {
+	tests := []struct {
+		name    string
+		ip1     string
+		ip2     string
+		want    []string
+		wantErr bool
+	}{
+		{"Solid range", "192.168.0.0", "192.168.1.255", []string{"192.168.0.0/23"}, false},
+		{"Split range", "10.0.0.1", "10.0.0.6", []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"}, false},
+		{"Whole space", "0.0.0.0", "255.255.255.255", []string{"0.0.0.0/0"}, false},
+		{"IPv6 split range", "2001:db8::", "2001:db8::1:0", []string{"2001:db8::/112", "2001:db8::1:0/128"}, false},
+		{"Wrong range", "10.0.0.6", "10.0.0.1", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IpRangeToCIDRs(tt.ip1, tt.ip2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IpRangeToCIDRs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IpRangeToCIDRs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDRToIpRange(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		want1   string
+		want2   string
+		wantErr bool
+	}{
+		{"192.168.0.0/23", "192.168.0.0", "192.168.1.255", false},
+		{"192.168.0.10/24", "192.168.0.0", "192.168.0.255", false},
+		{"10.0.0.1/32", "10.0.0.1", "10.0.0.1", false},
+		{"2001:db8::/112", "2001:db8::", "2001:db8::ffff", false},
+		{"192.168.0.0", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			got1, got2, err := CIDRToIpRange(tt.cidr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CIDRToIpRange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got1 != tt.want1 || got2 != tt.want2 {
+				t.Errorf("CIDRToIpRange() got = %v-%v, want %v-%v", got1, got2, tt.want1, tt.want2)
+			}
+		})
+	}
+}
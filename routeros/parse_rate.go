@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var rateUnits = []struct {
+	suffix string
+	value  uint64
+}{
+	{"G", 1000000000},
+	{"M", 1000000},
+	{"k", 1000},
+}
+
+// ParseRate Parsing the bit rate in the RouterOS notation: 10M ---> 10000000, 1.5k ---> 1500, 64000 ---> 64000
+func ParseRate(s string) (uint64, error) {
+	v := strings.TrimSpace(s)
+	if v == "" {
+		return 0, fmt.Errorf("invalid rate: %q", s)
+	}
+
+	var mult uint64 = 1
+	for _, u := range rateUnits {
+		if strings.HasSuffix(strings.ToUpper(v), strings.ToUpper(u.suffix)) {
+			mult, v = u.value, v[:len(v)-1]
+			break
+		}
+	}
+
+	if i, err := strconv.ParseUint(v, 10, 64); err == nil {
+		return i * mult, nil
+	}
+
+	// 1.5M
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 || strings.ContainsAny(v, "eEnNiI") {
+		return 0, fmt.Errorf("invalid rate: %q", s)
+	}
+	return uint64(f*float64(mult) + 0.5), nil
+}
+
+// FormatRate Formatting the bit rate with the largest suffix without the loss of precision: 10000000 ---> 10M
+func FormatRate(r uint64) string {
+	if r == 0 {
+		return "0"
+	}
+
+	for _, u := range rateUnits {
+		if r%u.value == 0 {
+			return strconv.FormatUint(r/u.value, 10) + u.suffix
+		}
+	}
+
+	return strconv.FormatUint(r, 10)
+}
+
+// NormalizeRate 10000000/10M, 10M/10000k ---> 10M/10M; values that are not rates are returned as is.
+func NormalizeRate(v string) string {
+	values := strings.Split(v, "/")
+	for i, value := range values {
+		r, err := ParseRate(value)
+		if err != nil {
+			return v
+		}
+		values[i] = FormatRate(r)
+	}
+
+	return strings.Join(values, "/")
+}
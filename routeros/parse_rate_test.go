@@ -0,0 +1,56 @@
+package routeros
+
+import "testing"
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    uint64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"64000", 64000, false},
+		{"1k", 1000, false},
+		{"1K", 1000, false},
+		{"10M", 10000000, false},
+		{"1.5M", 1500000, false},
+		{"2G", 2000000000, false},
+		{"", 0, true},
+		{"M", 0, true},
+		{"-1M", 0, true},
+		{"unlimited", 0, true},
+		{"1e3", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRate(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseRate() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRate(t *testing.T) {
+	tests := []struct {
+		in   uint64
+		want string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1k"},
+		{1500000, "1500k"},
+		{10000000, "10M"},
+		{1000000000, "1G"},
+		{1000000001, "1000000001"},
+	}
+	for _, tt := range tests {
+		if got := FormatRate(tt.in); got != tt.want {
+			t.Errorf("FormatRate(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
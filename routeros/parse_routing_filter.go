@@ -0,0 +1,215 @@
+package routeros
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// The structure check of the RouterOS 7 routing filter rule:
+// if (dst in 10.0.0.0/8 && dst-len > 24) { set distance 110; accept } else { reject }
+// Only the structure is checked (quotes, brackets, 'if'/'else' blocks), the matchers and the commands
+// are validated by the router itself.
+
+type routingFilterToken struct {
+	value string
+	pos   int
+}
+
+type routingFilterParser struct {
+	tokens []routingFilterToken
+	pos    int
+}
+
+// tokenizeRoutingFilter Splitting the rule into words, quoted strings and the '(', ')', '{', '}', ';' delimiters.
+func tokenizeRoutingFilter(s string) ([]routingFilterToken, error) {
+	var res []routingFilterToken
+
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.ContainsRune("(){};", c):
+			res = append(res, routingFilterToken{string(c), i})
+			i++
+		case c == '"':
+			start := i
+			for i++; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(s) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			res = append(res, routingFilterToken{s[start:i], start})
+		default:
+			start := i
+			for i < len(s) && !unicode.IsSpace(rune(s[i])) && !strings.ContainsRune("(){};\"", rune(s[i])) {
+				i++
+			}
+			res = append(res, routingFilterToken{s[start:i], start})
+		}
+	}
+
+	return res, nil
+}
+
+func (p *routingFilterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos].value
+	}
+	return ""
+}
+
+func (p *routingFilterParser) unexpected() error {
+	if p.pos < len(p.tokens) {
+		return fmt.Errorf("unexpected '%v' at position %d", p.tokens[p.pos].value, p.tokens[p.pos].pos)
+	}
+	return errors.New("unexpected end of rule")
+}
+
+func (p *routingFilterParser) expect(value string) error {
+	if p.peek() != value {
+		if p.pos < len(p.tokens) {
+			return fmt.Errorf("expected '%v' at position %d, got '%v'", value, p.tokens[p.pos].pos, p.tokens[p.pos].value)
+		}
+		return fmt.Errorf("expected '%v' at the end of rule", value)
+	}
+	p.pos++
+	return nil
+}
+
+// parentheses Skipping the balanced parentheses of the condition or the command argument.
+func (p *routingFilterParser) parentheses() error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	for depth := 1; depth > 0; p.pos++ {
+		switch p.peek() {
+		case "":
+			return errors.New("unclosed '(' at the end of rule")
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case "{", "}", ";":
+			return p.unexpected()
+		}
+	}
+
+	return nil
+}
+
+func (p *routingFilterParser) block() error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	if err := p.statements(true); err != nil {
+		return err
+	}
+	return p.expect("}")
+}
+
+func (p *routingFilterParser) statements(inBlock bool) error {
+	for {
+		switch p.peek() {
+		case "":
+			if inBlock {
+				return errors.New("unclosed '{' at the end of rule")
+			}
+			return nil
+		case "}":
+			if inBlock {
+				return nil
+			}
+			return p.unexpected()
+		case ";":
+			p.pos++
+		case "if":
+			p.pos++
+			if err := p.parentheses(); err != nil {
+				return err
+			}
+			if err := p.block(); err != nil {
+				return err
+			}
+			if p.peek() == "else" {
+				p.pos++
+				// else if (...) { ... }
+				if p.peek() == "if" {
+					continue
+				}
+				if err := p.block(); err != nil {
+					return err
+				}
+			}
+		case "else", "(", ")", "{":
+			return p.unexpected()
+		default:
+			// The command with its arguments: set distance 110, jump other-chain, accept.
+			p.pos++
+		command:
+			for {
+				switch p.peek() {
+				case "", ";", "}", "if", "else":
+					break command
+				case "(":
+					if err := p.parentheses(); err != nil {
+						return err
+					}
+				case ")", "{":
+					return p.unexpected()
+				default:
+					p.pos++
+				}
+			}
+		}
+	}
+}
+
+// ParseRoutingFilterRule Checking the structure of the routing filter rule.
+func ParseRoutingFilterRule(s string) error {
+	tokens, err := tokenizeRoutingFilter(s)
+	if err != nil {
+		return err
+	}
+
+	if len(tokens) == 0 {
+		return errors.New("empty rule")
+	}
+
+	p := &routingFilterParser{tokens: tokens}
+	return p.statements(false)
+}
+
+// RoutingFilterRuleEqual Comparing the rules regardless of the formatting: the router stores the rule
+// reformatted, so 'if (dst==10.0.0.0/8) {accept}' and 'if (dst==10.0.0.0/8) { accept; }' are the same rule.
+func RoutingFilterRuleEqual(r1, r2 string) bool {
+	t1, err := tokenizeRoutingFilter(r1)
+	if err != nil {
+		return r1 == r2
+	}
+	t2, err := tokenizeRoutingFilter(r2)
+	if err != nil {
+		return r1 == r2
+	}
+
+	var v1, v2 []string
+	for _, t := range t1 {
+		if t.value != ";" {
+			v1 = append(v1, t.value)
+		}
+	}
+	for _, t := range t2 {
+		if t.value != ";" {
+			v2 = append(v2, t.value)
+		}
+	}
+
+	return strings.Join(v1, " ") == strings.Join(v2, " ")
+}
@@ -0,0 +1,51 @@
+package routeros
+
+import "testing"
+
+func TestParseRoutingFilterRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{name: "accept", rule: "accept", wantErr: false},
+		{name: "if", rule: "if (dst in 10.0.0.0/8 && dst-len > 24) { set distance 110; accept }", wantErr: false},
+		{name: "if else", rule: "if (protocol bgp) { accept } else { reject }", wantErr: false},
+		{name: "else if", rule: "if (dst==10.0.0.0/8) { accept } else if (dst-len in 0-8) { reject } else { jump other }", wantErr: false},
+		{name: "nested", rule: "if ((bgp-as-path .65000.) || bgp-communities includes 65000:1) { if (afi ipv6) { reject } }", wantErr: false},
+		{name: "multiline", rule: "if (dst in 192.168.0.0/16) {\n  set bgp-med 10\n  accept\n}\n", wantErr: false},
+		{name: "quoted", rule: `set comment "a { b ( c"; accept`, wantErr: false},
+		{name: "empty", rule: " ", wantErr: true},
+		{name: "unclosed block", rule: "if (dst in 10.0.0.0/8) { accept", wantErr: true},
+		{name: "unclosed condition", rule: "if (dst in 10.0.0.0/8 { accept }", wantErr: true},
+		{name: "no condition", rule: "if { accept }", wantErr: true},
+		{name: "no block", rule: "if (dst in 10.0.0.0/8) accept", wantErr: true},
+		{name: "else without if", rule: "accept else { reject }", wantErr: true},
+		{name: "extra brace", rule: "accept }", wantErr: true},
+		{name: "unterminated string", rule: `set comment "abc`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ParseRoutingFilterRule(tt.rule); (err != nil) != tt.wantErr {
+				t.Errorf("ParseRoutingFilterRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRoutingFilterRuleEqual(t *testing.T) {
+	tests := []struct {
+		r1, r2 string
+		want   bool
+	}{
+		{"if (dst==10.0.0.0/8) {accept}", "if (dst==10.0.0.0/8) { accept; }", true},
+		{"if (dst==10.0.0.0/8) {\n  set distance 10\n  accept\n}", "if (dst==10.0.0.0/8) { set distance 10; accept }", true},
+		{"accept", "reject", false},
+		{`set comment "a  b"`, `set comment "a b"`, false},
+	}
+	for _, tt := range tests {
+		if got := RoutingFilterRuleEqual(tt.r1, tt.r2); got != tt.want {
+			t.Errorf("RoutingFilterRuleEqual(%q, %q) = %v, want %v", tt.r1, tt.r2, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package routeros
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -12,7 +13,7 @@ var (
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hosturl": {
 				Type:        schema.TypeString,
@@ -60,28 +61,64 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ROS_INSECURE", "MIKROTIK_INSECURE"}, false),
 				Description: "Whether to verify the SSL certificate or not.",
 			},
+			"unknown_fields": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ROS_UNKNOWN_FIELDS", "MIKROTIK_UNKNOWN_FIELDS"}, UnknownFieldsWarnOnce),
+				Description: `Handling of the fields returned by the router that are not in the resource schema (for example, 
+after a RouterOS upgrade): 'ignore' - skip them silently, 'warn_once' - one warning per resource type, 'error' - 
+fail the operation (strict mode for a known firmware version).`,
+				ValidateFunc: validation.StringInSlice([]string{UnknownFieldsIgnore, UnknownFieldsWarnOnce, UnknownFieldsError}, false),
+			},
+			"validate_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ROS_VALIDATE_REFERENCES", "MIKROTIK_VALIDATE_REFERENCES"}, false),
+				Description: `Check during the plan that the objects referenced by name (interfaces, bridges, interface lists,
+routing tables, etc.) exist on the router. Values that are not yet known, such as a reference to a resource
+created in the same plan, are not checked. Address lists are not checked, they only exist while they have entries.`,
+			},
+			"comment_tag_id": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ROS_COMMENT_TAG_ID", "MIKROTIK_COMMENT_TAG_ID"}, false),
+				Description: `Identify IP addresses and routes by the 'tf:<uuid>' tag written at the beginning of their
+comment instead of the '.id', so the state survives a backup restore or a router replacement. Resources that are
+already tagged keep being identified by the tag.`,
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 
 			// IP objects
-			"routeros_ip_dhcp_client":          ResourceDhcpClient(),
-			"routeros_ip_dhcp_server":          ResourceDhcpServer(),
-			"routeros_ip_dhcp_server_network":  ResourceDhcpServerNetwork(),
-			"routeros_ip_dhcp_server_lease":    ResourceDhcpServerLease(),
-			"routeros_ip_firewall_addr_list":   ResourceIPFirewallAddrList(),
-			"routeros_ip_firewall_filter":      ResourceIPFirewallFilter(),
-			"routeros_ip_firewall_mangle":      ResourceIPFirewallMangle(),
-			"routeros_ip_firewall_nat":         ResourceIPFirewallNat(),
-			"routeros_ip_address":              ResourceIPAddress(),
-			"routeros_ip_pool":                 ResourceIPPool(),
-			"routeros_ip_route":                ResourceIPRoute(),
-			"routeros_ip_dns":                  ResourceDns(),
-			"routeros_ip_dns_record":           ResourceDnsRecord(),
-			"routeros_ip_service":              ResourceIpService(),
-			"routeros_ipv6_address":            ResourceIPv6Address(),
-			"routeros_ipv6_firewall_addr_list": ResourceIPv6FirewallAddrList(),
-			"routeros_ipv6_firewall_filter":    ResourceIPv6FirewallFilter(),
-			"routeros_ipv6_route":              ResourceIPv6Route(),
+			"routeros_ip_dhcp_client":               ResourceDhcpClient(),
+			"routeros_ip_dhcp_server":               ResourceDhcpServer(),
+			"routeros_ip_dhcp_server_network":       ResourceDhcpServerNetwork(),
+			"routeros_ip_dhcp_server_lease":         ResourceDhcpServerLease(),
+			"routeros_ip_firewall_addr_list":        ResourceIPFirewallAddrList(),
+			"routeros_ip_firewall_filter":           ResourceIPFirewallFilter(),
+			"routeros_ip_firewall_filter_ruleset":   ResourceIPFirewallFilterRuleset(),
+			"routeros_ip_firewall_mangle":           ResourceIPFirewallMangle(),
+			"routeros_ip_firewall_mangle_ruleset":   ResourceIPFirewallMangleRuleset(),
+			"routeros_ip_firewall_nat":              ResourceIPFirewallNat(),
+			"routeros_ip_firewall_nat_ruleset":      ResourceIPFirewallNatRuleset(),
+			"routeros_ip_address":                   ResourceIPAddress(),
+			"routeros_ip_pool":                      ResourceIPPool(),
+			"routeros_ip_route":                     ResourceIPRoute(),
+			"routeros_ip_dns":                       ResourceDns(),
+			"routeros_ip_dns_record":                ResourceDnsRecord(),
+			"routeros_ip_service":                   ResourceIpService(),
+			"routeros_ip_hotspot":                   ResourceIpHotspot(),
+			"routeros_ip_hotspot_ip_binding":        ResourceIpHotspotIpBinding(),
+			"routeros_ip_hotspot_profile":           ResourceIpHotspotProfile(),
+			"routeros_ip_hotspot_user":              ResourceIpHotspotUser(),
+			"routeros_ip_hotspot_user_profile":      ResourceIpHotspotUserProfile(),
+			"routeros_ip_hotspot_walled_garden":     ResourceIpHotspotWalledGarden(),
+			"routeros_ip_hotspot_walled_garden_ip":  ResourceIpHotspotWalledGardenIp(),
+			"routeros_ipv6_address":                 ResourceIPv6Address(),
+			"routeros_ipv6_firewall_addr_list":      ResourceIPv6FirewallAddrList(),
+			"routeros_ipv6_firewall_filter":         ResourceIPv6FirewallFilter(),
+			"routeros_ipv6_firewall_filter_ruleset": ResourceIPv6FirewallFilterRuleset(),
+			"routeros_ipv6_route":                   ResourceIPv6Route(),
 
 			// Aliases for IP objects to retain compatibility between original and fork
 			"routeros_dhcp_client":         ResourceDhcpClient(),
@@ -96,21 +133,32 @@ func Provider() *schema.Provider {
 			"routeros_dns_record":          ResourceDnsRecord(),
 
 			// Interface Objects
-			"routeros_interface_bridge":          ResourceInterfaceBridge(),
-			"routeros_interface_bridge_port":     ResourceInterfaceBridgePort(),
-			"routeros_interface_bridge_vlan":     ResourceInterfaceBridgeVlan(),
-			"routeros_interface_bridge_settings": ResourceInterfaceBridgeSettings(),
-			"routeros_interface_gre":             ResourceInterfaceGre(),
-			"routeros_interface_vlan":            ResourceInterfaceVlan(),
-			"routeros_interface_vrrp":            ResourceInterfaceVrrp(),
-			"routeros_interface_wireguard":       ResourceInterfaceWireguard(),
-			"routeros_interface_wireguard_peer":  ResourceInterfaceWireguardPeer(),
-			"routeros_interface_list":            ResourceInterfaceList(),
-			"routeros_interface_list_member":     ResourceInterfaceListMember(),
-			"routeros_interface_ovpn_server":     ResourceInterfaceOpenVPNServer(),
-			"routeros_interface_veth":            ResourceInterfaceVeth(),
-			"routeros_interface_bonding":         ResourceInterfaceBonding(),
-			"routeros_interface_pppoe_client":    ResourceInterfacePPPoEClient(),
+			"routeros_interface_bridge":                     ResourceInterfaceBridge(),
+			"routeros_interface_ethernet":                   ResourceInterfaceEthernet(),
+			"routeros_interface_bridge_port":                ResourceInterfaceBridgePort(),
+			"routeros_interface_bridge_vlan":                ResourceInterfaceBridgeVlan(),
+			"routeros_interface_bridge_settings":            ResourceInterfaceBridgeSettings(),
+			"routeros_interface_gre":                        ResourceInterfaceGre(),
+			"routeros_interface_gre6":                       ResourceInterfaceGre6(),
+			"routeros_interface_eoip":                       ResourceInterfaceEoip(),
+			"routeros_interface_ipip":                       ResourceInterfaceIpip(),
+			"routeros_interface_6to4":                       ResourceInterface6to4(),
+			"routeros_interface_vxlan":                      ResourceInterfaceVxlan(),
+			"routeros_interface_vxlan_vteps":                ResourceInterfaceVxlanVteps(),
+			"routeros_interface_vlan":                       ResourceInterfaceVlan(),
+			"routeros_interface_vrrp":                       ResourceInterfaceVrrp(),
+			"routeros_interface_wireguard":                  ResourceInterfaceWireguard(),
+			"routeros_interface_wireguard_peer":             ResourceInterfaceWireguardPeer(),
+			"routeros_interface_list":                       ResourceInterfaceList(),
+			"routeros_interface_list_member":                ResourceInterfaceListMember(),
+			"routeros_interface_ovpn_server":                ResourceInterfaceOpenVPNServer(),
+			"routeros_interface_veth":                       ResourceInterfaceVeth(),
+			"routeros_interface_bonding":                    ResourceInterfaceBonding(),
+			"routeros_interface_pppoe_client":               ResourceInterfacePPPoEClient(),
+			"routeros_interface_wireless":                   ResourceInterfaceWireless(),
+			"routeros_interface_wireless_security_profiles": ResourceInterfaceWirelessSecurityProfiles(),
+			"routeros_interface_wireless_access_list":       ResourceInterfaceWirelessAccessList(),
+			"routeros_interface_wireless_connect_list":      ResourceInterfaceWirelessConnectList(),
 
 			// Aliases for interface objects to retain compatibility between original and fork
 			"routeros_bridge":         ResourceInterfaceBridge(),
@@ -132,12 +180,7 @@ func Provider() *schema.Provider {
 			"routeros_identity":  ResourceSystemIdentity(),
 			"routeros_scheduler": ResourceSystemScheduler(),
 
-			// TODO: Review whether capsman resources need updating given wifiwave2.
-			// wifiwave2 is getting support for capsman in 7.8.
-			// Should we support both legacy capsman _and_ wifiwave2 capsman?
-			// https://help.mikrotik.com/docs/display/ROS/WifiWave2#WifiWave2-WifiWave2CAPsMAN
-
-			// CAPsMAN Objects
+			// CAPsMAN Objects (the legacy wireless package, see the WiFi Objects for the wifi/wifiwave2 package)
 			"routeros_capsman_channel":           ResourceCapsManChannel(),
 			"routeros_capsman_configuration":     ResourceCapsManConfiguration(),
 			"routeros_capsman_datapath":          ResourceCapsManDatapath(),
@@ -148,27 +191,83 @@ func Provider() *schema.Provider {
 			"routeros_capsman_rates":             ResourceCapsManRates(),
 			"routeros_capsman_security":          ResourceCapsManSecurity(),
 
+			// WiFi Objects
+			"routeros_wifi":               ResourceWifi(),
+			"routeros_wifi_access_list":   ResourceWifiAccessList(),
+			"routeros_wifi_cap":           ResourceWifiCap(),
+			"routeros_wifi_capsman":       ResourceWifiCapsman(),
+			"routeros_wifi_channel":       ResourceWifiChannel(),
+			"routeros_wifi_configuration": ResourceWifiConfiguration(),
+			"routeros_wifi_datapath":      ResourceWifiDatapath(),
+			"routeros_wifi_provisioning":  ResourceWifiProvisioning(),
+			"routeros_wifi_security":      ResourceWifiSecurity(),
+			"routeros_wifi_steering":      ResourceWifiSteering(),
+
 			// Routing
-			"routeros_routing_table":          ResourceRoutingTable(),
-			"routeros_routing_bgp_connection": ResourceRoutingBGPConnection(),
-			"routeros_routing_bgp_template":   ResourceRoutingBGPTemplate(),
+			"routeros_routing_table":                   ResourceRoutingTable(),
+			"routeros_routing_rule":                    ResourceRoutingRule(),
+			"routeros_routing_filter_rule":             ResourceRoutingFilterRule(),
+			"routeros_routing_bgp_connection":          ResourceRoutingBGPConnection(),
+			"routeros_routing_bgp_template":            ResourceRoutingBGPTemplate(),
+			"routeros_routing_ospf_instance":           ResourceRoutingOspfInstance(),
+			"routeros_routing_ospf_area":               ResourceRoutingOspfArea(),
+			"routeros_routing_ospf_area_range":         ResourceRoutingOspfAreaRange(),
+			"routeros_routing_ospf_interface_template": ResourceRoutingOspfInterfaceTemplate(),
+			"routeros_routing_ospf_static_neighbor":    ResourceRoutingOspfStaticNeighbor(),
+
+			// Containers
+			"routeros_container":        ResourceContainer(),
+			"routeros_container_config": ResourceContainerConfig(),
+			"routeros_container_envs":   ResourceContainerEnvs(),
+			"routeros_container_mounts": ResourceContainerMounts(),
+
+			// Queues
+			"routeros_queue_simple": ResourceQueueSimple(),
+			"routeros_queue_tree":   ResourceQueueTree(),
+			"routeros_queue_type":   ResourceQueueType(),
 
 			// VPN
 			"routeros_ovpn_server": ResourceOpenVPNServer(),
 
+			// IPsec
+			"routeros_ip_ipsec_identity":     ResourceIPIpsecIdentity(),
+			"routeros_ip_ipsec_mode_config":  ResourceIPIpsecModeConfig(),
+			"routeros_ip_ipsec_peer":         ResourceIPIpsecPeer(),
+			"routeros_ip_ipsec_policy":       ResourceIPIpsecPolicy(),
+			"routeros_ip_ipsec_policy_group": ResourceIPIpsecPolicyGroup(),
+			"routeros_ip_ipsec_profile":      ResourceIPIpsecProfile(),
+			"routeros_ip_ipsec_proposal":     ResourceIPIpsecProposal(),
+
 			// PPP
 			"routeros_ppp_profile": ResourcePPPProfile(),
 			"routeros_ppp_secret":  ResourcePPPSecret(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"routeros_interfaces":     DatasourceInterfaces(),
-			"routeros_ip_addresses":   DatasourceIPAddresses(),
-			"routeros_ip_routes":      DatasourceIPRoutes(),
-			"routeros_firewall":       DatasourceFirewall(),
-			"routeros_ipv6_addresses": DatasourceIPv6Addresses(),
+			"routeros_interfaces":             DatasourceInterfaces(),
+			"routeros_ip_addresses":           DatasourceIPAddresses(),
+			"routeros_ip_routes":              DatasourceIPRoutes(),
+			"routeros_ip_hotspot_active":      DatasourceIpHotspotActive(),
+			"routeros_firewall":               DatasourceFirewall(),
+			"routeros_ipv6_addresses":         DatasourceIPv6Addresses(),
+			"routeros_routing_ospf_neighbors": DatasourceRoutingOspfNeighbors(),
+			"routeros_routing_ospf_lsa":       DatasourceRoutingOspfLsa(),
 		},
 		ConfigureContextFunc: NewClient,
 	}
+
+	for resourceType, r := range provider.ResourcesMap {
+		// Comparison of the field values in the canonical form.
+		applyNormalizers(r.Schema)
+		applyUnknownFieldsPolicy(resourceType, r)
+		// Deprecation of the aliases and state upgraders of the aliased resources.
+		applyAliasPolicy(resourceType, r)
+	}
+
+	for dataSourceType, r := range provider.DataSourcesMap {
+		applyUnknownFieldsPolicy(dataSourceType, r)
+	}
+
+	return provider
 }
 
 func NewProvider() *schema.Provider {
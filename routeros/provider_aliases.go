@@ -0,0 +1,97 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAliases The resources that were kept to retain compatibility between the original provider and the fork.
+// Alias -> canonical resource type.
+var resourceAliases = map[string]string{
+	"routeros_dhcp_client":         "routeros_ip_dhcp_client",
+	"routeros_dhcp_server":         "routeros_ip_dhcp_server",
+	"routeros_dhcp_server_network": "routeros_ip_dhcp_server_network",
+	"routeros_dhcp_server_lease":   "routeros_ip_dhcp_server_lease",
+	"routeros_firewall_addr_list":  "routeros_ip_firewall_addr_list",
+	"routeros_firewall_filter":     "routeros_ip_firewall_filter",
+	"routeros_firewall_mangle":     "routeros_ip_firewall_mangle",
+	"routeros_firewall_nat":        "routeros_ip_firewall_nat",
+	"routeros_dns":                 "routeros_ip_dns",
+	"routeros_dns_record":          "routeros_ip_dns_record",
+	"routeros_bridge":              "routeros_interface_bridge",
+	"routeros_bridge_port":         "routeros_interface_bridge_port",
+	"routeros_bridge_vlan":         "routeros_interface_bridge_vlan",
+	"routeros_gre":                 "routeros_interface_gre",
+	"routeros_vlan":                "routeros_interface_vlan",
+	"routeros_vrrp":                "routeros_interface_vrrp",
+	"routeros_wireguard":           "routeros_interface_wireguard",
+	"routeros_wireguard_peer":      "routeros_interface_wireguard_peer",
+	"routeros_identity":            "routeros_system_identity",
+	"routeros_scheduler":           "routeros_system_scheduler",
+}
+
+// aliasedSchemaVersion The schema version of the canonical resources and their aliases.
+// 0 - the state written before the versioning of the aliased resources.
+const aliasedSchemaVersion = 1
+
+// applyAliasPolicy Deprecation of the aliases and the state versioning of the aliased resources.
+func applyAliasPolicy(resourceType string, r *schema.Resource) {
+	canonical, isAlias := resourceAliases[resourceType]
+	if isAlias {
+		r.DeprecationMessage = fmt.Sprintf("The '%v' resource is deprecated and will be removed in a future "+
+			"version, use '%v' instead. To keep the object on the router, move it to the new type with the 'moved' "+
+			"block (Terraform 1.8+) or remove the resource from the state and import it under the new type.",
+			resourceType, canonical)
+	}
+
+	if !isAlias && !isAliasedResource(resourceType) {
+		return
+	}
+
+	// Aliases share the schema of the canonical resource, so their states have the same version.
+	r.SchemaVersion = aliasedSchemaVersion
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    r.CoreConfigSchema().ImpliedType(),
+			Upgrade: stateUpgradeMetadataV0(r.Schema),
+		},
+	}
+}
+
+// isAliasedResource Returns true if the resource type has an alias.
+func isAliasedResource(resourceType string) bool {
+	for _, canonical := range resourceAliases {
+		if canonical == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// stateUpgradeMetadataV0 The state of version 0 may be written by an earlier version of the provider or by the alias:
+// the metadata fields are set to the current values and the fields that are no longer in the schema are removed.
+func stateUpgradeMetadataV0(s map[string]*schema.Schema) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		for name := range rawState {
+			if _, ok := s[name]; !ok && name != "id" {
+				ColorizedDebug(ctx, fmt.Sprintf("[stateUpgradeMetadataV0] the field '%v' was removed from the state", name))
+				delete(rawState, name)
+			}
+		}
+
+		for name, field := range s {
+			if reMetadataFields.MatchString(name) {
+				rawState[name] = field.Default
+			}
+		}
+
+		return rawState, nil
+	}
+}
@@ -0,0 +1,62 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_resourceAliases(t *testing.T) {
+	p := Provider()
+
+	if err := p.InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for alias, canonical := range resourceAliases {
+		a, ok := p.ResourcesMap[alias]
+		if !ok {
+			t.Errorf("the alias '%v' is not registered", alias)
+			continue
+		}
+		c, ok := p.ResourcesMap[canonical]
+		if !ok {
+			t.Errorf("the canonical resource '%v' of the alias '%v' is not registered", canonical, alias)
+			continue
+		}
+
+		if a.DeprecationMessage == "" || c.DeprecationMessage != "" {
+			t.Errorf("only the alias '%v' must be deprecated", alias)
+		}
+		if a.SchemaVersion != aliasedSchemaVersion || c.SchemaVersion != aliasedSchemaVersion {
+			t.Errorf("wrong schema version of '%v' or '%v'", alias, canonical)
+		}
+		if GetMetadata(a.Schema).Path != GetMetadata(c.Schema).Path || len(a.Schema) != len(c.Schema) {
+			t.Errorf("the alias '%v' and '%v' have different schemas", alias, canonical)
+		}
+	}
+}
+
+func Test_stateUpgradeMetadataV0(t *testing.T) {
+	r := ResourceInterfaceBridge()
+	rawState := map[string]interface{}{
+		"id":             "bridge",
+		"name":           "bridge",
+		MetaResourcePath: "/interface/bridge/old",
+		"removed_field":  "value",
+	}
+
+	actual, err := stateUpgradeMetadataV0(r.Schema)(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual["id"] != "bridge" || actual["name"] != "bridge" {
+		t.Errorf("the resource fields must be kept: %v", actual)
+	}
+	if actual[MetaResourcePath] != "/interface/bridge" || actual[MetaId] != int(Name) {
+		t.Errorf("the metadata fields must be set to the current values: %v", actual)
+	}
+	if _, ok := actual["removed_field"]; ok {
+		t.Error("the field that is not in the schema must be removed")
+	}
+}
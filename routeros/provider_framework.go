@@ -0,0 +1,159 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// FrameworkProvider The part of the provider built on the terraform-plugin-framework.
+// It is served together with the SDK provider (see NewProviderServer) and shares its configuration and client.
+type FrameworkProvider struct {
+	sdk *schema.Provider
+}
+
+var _ provider.ProviderWithFunctions = &FrameworkProvider{}
+
+// frameworkResources Resources implemented on the terraform-plugin-framework.
+var frameworkResources = []func() resource.Resource{
+	ResourceIPFirewallLayer7Protocol(),
+}
+
+// frameworkDataSources Data sources implemented on the terraform-plugin-framework.
+var frameworkDataSources []func() datasource.DataSource
+
+// frameworkFunctions Provider functions: provider::routeros::<name>(...)
+var frameworkFunctions = []func() function.Function{
+	NewFunctionParseDuration,
+	NewFunctionFormatDuration,
+	NewFunctionIpRangeToCIDR,
+	NewFunctionCIDRToIpRange,
+	NewFunctionNormalizeMac,
+}
+
+func NewFrameworkProvider(sdk *schema.Provider) provider.Provider {
+	return &FrameworkProvider{sdk: sdk}
+}
+
+func (p *FrameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "routeros"
+}
+
+// Schema The provider schema must be identical to the SDK provider schema, so it is converted from it.
+func (p *FrameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = fwschema.Schema{
+		Attributes: make(map[string]fwschema.Attribute),
+	}
+
+	for name, a := range schema.InternalMap(p.sdk.Schema).CoreConfigSchema().Attributes {
+		switch a.Type {
+		case cty.String:
+			resp.Schema.Attributes[name] = fwschema.StringAttribute{
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: a.DeprecationMessage,
+			}
+		case cty.Bool:
+			resp.Schema.Attributes[name] = fwschema.BoolAttribute{
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: a.DeprecationMessage,
+			}
+		case cty.Number:
+			resp.Schema.Attributes[name] = fwschema.Int64Attribute{
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: a.DeprecationMessage,
+			}
+		default:
+			resp.Diagnostics.AddError("Provider schema conversion",
+				fmt.Sprintf("the type of the provider attribute '%v' is not implemented: %v", name, a.Type.FriendlyName()))
+		}
+	}
+}
+
+// Configure The SDK provider is configured first by the mux server, so its client is shared.
+// Otherwise, the SDK provider is configured with the same configuration.
+func (p *FrameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	if c, ok := p.sdk.Meta().(Client); ok {
+		resp.ResourceData, resp.DataSourceData = c, c
+		return
+	}
+
+	var values map[string]tftypes.Value
+	if err := req.Config.Raw.As(&values); err != nil {
+		resp.Diagnostics.AddError("Provider configuration", err.Error())
+		return
+	}
+
+	raw := make(map[string]interface{})
+	for name, v := range values {
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		var err error
+		switch {
+		case v.Type().Is(tftypes.String):
+			var s string
+			err = v.As(&s)
+			raw[name] = s
+		case v.Type().Is(tftypes.Bool):
+			var b bool
+			err = v.As(&b)
+			raw[name] = b
+		case v.Type().Is(tftypes.Number):
+			var n big.Float
+			err = v.As(&n)
+			i, _ := n.Int64()
+			raw[name] = int(i)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Provider configuration", err.Error())
+			return
+		}
+	}
+
+	for _, d := range p.sdk.Configure(ctx, terraform.NewResourceConfigRaw(raw)) {
+		if d.Severity == diag.Error {
+			resp.Diagnostics.AddError(d.Summary, d.Detail)
+		} else {
+			resp.Diagnostics.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := p.sdk.Meta().(Client)
+	resp.ResourceData, resp.DataSourceData = c, c
+}
+
+func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return frameworkResources
+}
+
+func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return frameworkDataSources
+}
+
+func (p *FrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return frameworkFunctions
+}
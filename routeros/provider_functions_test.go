@@ -0,0 +1,61 @@
+package routeros
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviderFunctions(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		function function.Function
+		argument attr.Value
+		result   attr.Value
+		wantErr  bool
+	}{
+		{NewFunctionParseDuration(), types.StringValue("1d2h"), types.NumberValue(big.NewFloat(93600)), false},
+		{NewFunctionParseDuration(), types.StringValue("1x"), types.NumberUnknown(), true},
+		{NewFunctionFormatDuration(), types.NumberValue(big.NewFloat(93600)), types.StringValue("1d2h"), false},
+		{NewFunctionFormatDuration(), types.NumberValue(big.NewFloat(0.5)), types.StringValue("500ms"), false},
+		{NewFunctionFormatDuration(), types.NumberValue(big.NewFloat(1e20)), types.StringUnknown(), true},
+		{NewFunctionIpRangeToCIDR(), types.StringValue("10.0.0.1-10.0.0.3"),
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("10.0.0.1/32"), types.StringValue("10.0.0.2/31"),
+			}), false},
+		{NewFunctionIpRangeToCIDR(), types.StringValue("10.0.0.1"), types.ListUnknown(types.StringType), true},
+		{NewFunctionCIDRToIpRange(), types.StringValue("2001:db8::/112"), types.StringValue("2001:db8::-2001:db8::ffff"), false},
+		{NewFunctionNormalizeMac(), types.StringValue("4c5e.0c00.0001"), types.StringValue("4C:5E:0C:00:00:01"), false},
+		{NewFunctionNormalizeMac(), types.StringValue("4c5e"), types.StringUnknown(), true},
+	}
+	for _, tt := range tests {
+		var meta function.MetadataResponse
+		tt.function.Metadata(ctx, function.MetadataRequest{}, &meta)
+
+		t.Run(meta.Name+"("+tt.argument.String()+")", func(t *testing.T) {
+			var def function.DefinitionResponse
+			tt.function.Definition(ctx, function.DefinitionRequest{}, &def)
+			if def.Diagnostics.HasError() {
+				t.Fatal(def.Diagnostics)
+			}
+
+			resp := &function.RunResponse{Result: function.NewResultData(def.Definition.Return.GetType().ValueType(ctx))}
+			tt.function.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tt.argument})}, resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !resp.Result.Value().Equal(tt.result) {
+				t.Errorf("got = %v, want %v", resp.Result.Value(), tt.result)
+			}
+		})
+	}
+}
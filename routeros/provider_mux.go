@@ -0,0 +1,60 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// NewProviderServer Serving the SDK provider and the framework provider as a single provider.
+func NewProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdk := Provider()
+
+	mux, err := tf5muxserver.NewMuxServer(ctx,
+		// The SDK provider must be the first one, its client is shared with the framework provider.
+		sdk.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdk)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return &aliasMoveServer{ProviderServer: mux.ProviderServer()}
+	}, nil
+}
+
+// aliasMoveServer Support of the 'moved' blocks from the aliases to the canonical resources:
+//
+//	moved {
+//	  from = routeros_bridge.lan
+//	  to   = routeros_interface_bridge.lan
+//	}
+type aliasMoveServer struct {
+	tfprotov5.ProviderServer
+}
+
+// MoveResourceState The alias and the canonical resource share the schema, so the state of the alias is upgraded
+// as the state of the canonical resource.
+func (s *aliasMoveServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	if canonical, ok := resourceAliases[req.SourceTypeName]; !ok || canonical != req.TargetTypeName {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+
+	res, err := s.ProviderServer.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: req.TargetTypeName,
+		Version:  req.SourceSchemaVersion,
+		RawState: req.SourceState,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.MoveResourceStateResponse{
+		TargetState:   res.UpgradedState,
+		TargetPrivate: req.SourcePrivate,
+		Diagnostics:   res.Diagnostics,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestNewProviderServer(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := NewProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The mux checks that the provider schemas of the SDK and the framework providers are the same.
+	res, err := server().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range res.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%v: %v", d.Summary, d.Detail)
+		}
+	}
+
+	if _, ok := res.ResourceSchemas["routeros_interface_bridge"]; !ok {
+		t.Error("the SDK resources must be served")
+	}
+	if _, ok := res.ResourceSchemas["routeros_ip_firewall_layer7_protocol"]; !ok {
+		t.Error("the framework resources must be served")
+	}
+}
@@ -2,9 +2,11 @@ package routeros
 
 import (
 	"fmt"
+	"net/netip"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,24 +20,38 @@ const (
 	MetaResourcePath = "___path___"
 	MetaTransformSet = "___ts___"
 	MetaSkipFields   = "___skip___"
+	MetaNestedBlocks = "___nested___"
+	MetaReferences   = "___refs___"
+	MetaNormalize    = "___norm___"
+	MetaSecrets      = "___secrets___"
 )
 
 const (
-	KeyActualMtu   = "actual_mtu"
-	KeyArp         = "arp"
-	KeyArpTimeout  = "arp_timeout"
-	KeyComment     = "comment"
-	KeyDynamic     = "dynamic"
-	KeyDisabled    = "disabled"
-	KeyFilter      = "filter"
-	KeyInterface   = "interface"
-	KeyInvalid     = "invalid"
-	KeyL2Mtu       = "l2mtu"
-	KeyMacAddress  = "mac_address"
-	KeyMtu         = "mtu"
-	KeyName        = "name"
-	KeyPlaceBefore = "place_before"
-	KeyRunning     = "running"
+	KeyActualMtu     = "actual_mtu"
+	KeyAllowFastPath = "allow_fast_path"
+	KeyArp           = "arp"
+	KeyArpTimeout    = "arp_timeout"
+	KeyClampTcpMss   = "clamp_tcp_mss"
+	KeyComment       = "comment"
+	KeyDontFragment  = "dont_fragment"
+	KeyDscp          = "dscp"
+	KeyDynamic       = "dynamic"
+	KeyDisabled      = "disabled"
+	KeyFilter        = "filter"
+	KeyInterface     = "interface"
+	KeyInvalid       = "invalid"
+	KeyIpsecSecret   = "ipsec_secret"
+	KeyKeepalive     = "keepalive"
+	KeyL2Mtu         = "l2mtu"
+	KeyMacAddress    = "mac_address"
+	KeyMtu           = "mtu"
+	KeyName          = "name"
+	KeyPlaceBefore   = "place_before"
+	KeyRunning       = "running"
+
+	KeyResetOnDestroy = "reset_on_destroy"
+	KeySecretHashes   = "secret_hashes"
+	KeyVerifySecret   = "verify_secret"
 )
 
 // PropResourcePath Resource path property.
@@ -90,6 +106,65 @@ func PropSkipFields(s string) *schema.Schema {
 	}
 }
 
+// PropNestedBlocks Encoding strategies for the lists and sets of nested blocks.
+// s: `"networks":"joined","options":"indexed","children":"items:/queue/tree:parent"` in the TF (snake) notation!
+// The blocks of the sub-path items must have the computed 'id' field: only the recorded items are managed.
+func PropNestedBlocks(s string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     s,
+		Description: "<em>A set of encoding strategies for nested blocks. This is an internal service field, setting a value is not required.</em>",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return true
+		},
+	}
+}
+
+// PropReferences Fields that contain the names of other objects on the router.
+// s: `"interface":"/interface","list":"/ip/firewall/address-list:list"` in the TF (snake) notation!
+// The referenced field of the target object is 'name' unless specified after the path.
+// Literal values that do not refer to any object are listed after the path: `"address_pool":"/ip/pool|static-only"`.
+func PropReferences(s string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     s,
+		Description: "<em>A set of references to other objects. This is an internal service field, setting a value is not required.</em>",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return true
+		},
+	}
+}
+
+// PropNormalize Normalizers of the field values, see 'normalizers' for the list of stock normalizers.
+// s: `"cache_max_ttl":"duration","mac_address":"mac","fib":"flag"` in the TF (snake) notation!
+func PropNormalize(s string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     s,
+		Description: "<em>A set of normalizers for field values. This is an internal service field, setting a value is not required.</em>",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return true
+		},
+	}
+}
+
+// PropSecrets Write-only fields whose values are tracked by the salted hash of the last value sent to the router.
+// s: `"password","secret"` in the TF (snake) notation!
+func PropSecrets(s string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     s,
+		Description: "<em>A set of write-only fields. This is an internal service field, setting a value is not required.</em>",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return true
+		},
+	}
+}
+
 // PropName
 func PropName(description string) *schema.Schema {
 	return &schema.Schema{
@@ -99,12 +174,30 @@ func PropName(description string) *schema.Schema {
 	}
 }
 
+// PropVerifySecret Verify the secret on the router while its hash is unknown, the verification method is described
+// by the resource.
+func PropVerifySecret(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Verify the secret on the router during the refresh if the state has no hash of it (after the " +
+			"import) and plan an update if it does not match. " + description,
+	}
+}
+
 // Schema properties.
 var (
 	PropActualMtuRo = &schema.Schema{
 		Type:     schema.TypeInt,
 		Computed: true,
 	}
+	PropAllowFastPathRw = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true, // Must be present in the request so that the IPSEC PSK can be set correctly.
+		Default:     true,
+		Description: "Whether to allow FastPath processing. Must be disabled if IPsec tunneling is used.",
+	}
 	PropArpRw = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -123,6 +216,15 @@ var (
 		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^$|auto$|(\d+(ms|s|M|h|d)?)+$`),
 			"expected arp_timout value to be 'auto' string or time value"),
 	}
+	PropClampTcpMssRw = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+		Description: "Controls whether to change MSS size for received TCP SYN packets. When enabled, a " +
+			"router will change the MSS size for received TCP SYN packets if the current MSS size exceeds the " +
+			"tunnel interface MTU (taking into account the TCP/IP overhead). The received encapsulated packet " +
+			"will still contain the original MSS, and only after decapsulation the MSS is changed.",
+	}
 	PropCommentRw = &schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
@@ -132,6 +234,40 @@ var (
 		Optional: true,
 		Default:  false,
 	}
+	PropDontFragmentRw = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "no",
+		ValidateFunc: validation.StringInSlice([]string{"inherit", "no"}, false),
+	}
+	PropDscpRw = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "inherit",
+		ValidateDiagFunc: func(v interface{}, p cty.Path) (diags diag.Diagnostics) {
+			value := v.(string)
+
+			// dscp (inherit | integer [0-63]; Default: '')
+			if value == "" || value == "inherit" {
+				return
+			}
+
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				diags = diag.Errorf(
+					"expected dscp value (%s) to be empty string or 'inherit' or integer 0..63", value)
+				return
+			}
+			if i < 0 || i > 63 {
+				diags = diag.Errorf(
+					"expected %s to be in the range 0 - 63, got %d", value, i)
+				return
+			}
+			return
+		},
+		Description: "Set dscp value in the tunnel header to a fixed value '0..63' or 'inherit' from dscp value taken " +
+			"from tunnelled traffic.",
+	}
 	PropDynamicRo = &schema.Schema{
 		Type:     schema.TypeBool,
 		Computed: true,
@@ -153,6 +289,37 @@ var (
 		Type:     schema.TypeBool,
 		Computed: true,
 	}
+	PropIpsecSecretRw = &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		Default:   "",
+		Sensitive: true,
+		Description: "When secret is specified, router adds dynamic IPsec peer to remote-address with " +
+			"pre-shared key and policy (by default phase2 uses sha1/aes128cbc).",
+	}
+	PropKeepaliveRw = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "10s,10",
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(\d+[smhdw]?)+(,\d+)?$`),
+			"value must be integer[/time],integer 0..4294967295 (https://help.mikrotik.com/docs/display/ROS/GRE)"),
+		Description: "Tunnel keepalive parameter sets the time interval in which the tunnel running flag will " +
+			"remain even if the remote end of tunnel goes down. If configured time,retries fail, interface " +
+			"running flag is removed. Parameters are written in following format: " +
+			"KeepaliveInterval,KeepaliveRetries where KeepaliveInterval is time interval and " +
+			"KeepaliveRetries - number of retry attempts. KeepaliveInterval is integer 0..4294967295",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			if old == new {
+				return true
+			}
+
+			if old == "" || new == "" {
+				return false
+			}
+
+			return keepaliveEqual(old, new)
+		},
+	}
 	PropL2MtuRo = &schema.Schema{
 		Type:        schema.TypeInt,
 		Computed:    true,
@@ -175,12 +342,28 @@ var (
 	PropPlaceBefore = &schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
-		ForceNew: true,
-		Description: `Before which position the rule will be inserted.  
-	> Please check the effect of this option, as it does not work as you think!  
-	> Best way to use in conjunction with a data source. See [example](../data-sources/firewall.md#example-usage).  
+		Description: `ID of the rule before which this rule will be placed.  
+	> Changing this value moves the rule in place with the RouterOS 'move' command, the rule is not recreated.  
+	> If the rule is moved after this position outside of Terraform, the next plan will move it back.  
+	> Best way to use in conjunction with other rule resources or a data source. See [example](../data-sources/firewall.md#example-usage).  
 `,
 	}
+	PropResetOnDestroy = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: `Reset the settings to the factory defaults when the resource is destroyed. By default, the settings 
+	remain on the router and the resource is only removed from the Terraform state.`,
+	}
+	PropSecretHashesRo = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "Salted hashes of the secret values last sent to the router, used to detect the changes made outside of Terraform.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
 	PropRunningRo = &schema.Schema{
 		Type:     schema.TypeBool,
 		Computed: true,
@@ -224,15 +407,21 @@ func PropMtuRw() *schema.Schema {
 	}
 }
 
+// TunnelIpsecValidate FastPath can not be enabled together with IPsec on the tunnel interfaces.
+func TunnelIpsecValidate(d *schema.ResourceData) diag.Diagnostics {
+	if d.Get(KeyAllowFastPath).(bool) && d.Get(KeyIpsecSecret).(string) != "" {
+		return diag.Errorf("can't enable fastpath together with ipsec")
+	}
+	return nil
+}
+
 // Properties validation.
 var (
 	ValidationTime = validation.StringMatch(regexp.MustCompile(`^(\d+([smhdw]|ms)?)+$`),
 		"value should be an integer or a time interval: 0..4294967295 (seconds) or 500ms, 2d, 1w")
 	ValidationAutoYesNo = validation.StringInSlice([]string{"auto", "yes", "no"}, false)
-	ValidationIpAddress = validation.StringMatch(
-		regexp.MustCompile(`^$|^!?(\b(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)(/([0-9]|[0-9]|[1-2][0-9]|3[0-2]))?)$`),
-		"Allowed addresses should be a CIDR IP address or an empty string",
-	)
+	// ValidationIpAddress [!]IPv4 or IPv6 address with an optional prefix length or an empty string.
+	ValidationIpAddress  = ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix | IpNegation | IpEmpty)
 	ValidationMacAddress = validation.StringMatch(
 		regexp.MustCompile(`^!?\b(?:[0-9A-F]{2}\:){5}(?:[0-9A-F]{2})$`),
 		"Allowed MAC addresses should be [!]AA:BB:CC:DD:EE:FF",
@@ -286,57 +475,184 @@ var (
 	}
 )
 
-// Properties DiffSuppressFunc.
-var (
-	TimeEquall = func(k, old, new string, d *schema.ResourceData) bool {
-		if old == new {
-			return true
+// IpFlags The allowed forms of the IP values for ValidationIp.
+type IpFlags uint
+
+const (
+	IpV4       IpFlags = 1 << iota // IPv4 values.
+	IpV6                           // IPv6 values.
+	IpAddress                      // 10.0.0.1
+	IpPrefix                       // 10.0.0.0/24
+	IpRange                        // 10.0.0.1-10.0.0.10
+	IpNegation                     // !10.0.0.1
+	IpList                         // 10.0.0.1,10.0.0.0/24
+	IpEmpty                        // An empty string.
+)
+
+// ValidationIp Returns the validator of IP values, the allowed families and forms are set by the flags:
+// ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix | IpRange | IpNegation) accepts a firewall matcher such as
+// '!10.0.0.0/8' or '2001:db8::1-2001:db8::ff'.
+func ValidationIp(flags IpFlags) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
 		}
 
-		if old == "" || new == "" {
-			return false
+		if v == "" {
+			if flags&IpEmpty == 0 {
+				errors = append(errors, fmt.Errorf("expected %q to not be an empty string", k))
+			}
+			return
 		}
 
-		// Compare intervals:
-		oDuration, err := ParseDuration(old)
-		if err != nil {
-			panic("[TimeEquall] parse 'old' duration error: " + err.Error())
+		values := []string{v}
+		if flags&IpList != 0 {
+			values = strings.Split(v, ",")
 		}
 
-		nDuration, err := ParseDuration(new)
-		if err != nil {
-			panic("[TimeEquall] parse 'new' duration error: " + err.Error())
+		for _, value := range values {
+			if err := validateIpValue(strings.TrimSpace(value), flags); err != nil {
+				errors = append(errors, fmt.Errorf("expected %q to contain %v, got %q: %w", k, flags, value, err))
+			}
 		}
 
-		return oDuration.Seconds() == nDuration.Seconds()
+		return
 	}
+}
 
-	HexEqual = func(k, old, new string, d *schema.ResourceData) bool {
-		if old == new {
-			return true
-		}
+func validateIpValue(v string, flags IpFlags) error {
+	if strings.HasPrefix(v, "!") && flags&IpNegation != 0 {
+		v = v[1:]
+	}
 
-		if old == "" || new == "" {
-			return false
+	checkFamily := func(a netip.Addr) error {
+		if a.Zone() != "" {
+			return fmt.Errorf("the zone is not allowed")
+		}
+		if (a.Is4() || a.Is4In6()) && flags&IpV4 == 0 || a.Is6() && !a.Is4In6() && flags&IpV6 == 0 {
+			return fmt.Errorf("the address family is not allowed")
 		}
+		return nil
+	}
 
-		// Compare numbers:
-		var iOld, iNew int64
-		var err error
+	switch {
+	case strings.Contains(v, "/"):
+		if flags&IpPrefix == 0 {
+			return fmt.Errorf("the prefix is not allowed")
+		}
+		p, err := netip.ParsePrefix(v)
+		if err != nil {
+			return err
+		}
+		return checkFamily(p.Addr())
 
-		iOld, err = strconv.ParseInt(old, 0, 64)
+	case strings.Contains(v, "-"):
+		if flags&IpRange == 0 {
+			return fmt.Errorf("the range is not allowed")
+		}
+		ip1, ip2, err := ParseIpRange(v)
+		if err != nil {
+			return err
+		}
+		a1, _, err := parseIpRange(ip1, ip2)
 		if err != nil {
-			panic("[HexEqual] 'old' number parse error: " + err.Error())
+			return err
 		}
+		return checkFamily(a1)
 
-		iNew, err = strconv.ParseInt(new, 0, 64)
+	default:
+		if flags&IpAddress == 0 {
+			return fmt.Errorf("the single address is not allowed")
+		}
+		a, err := netip.ParseAddr(v)
 		if err != nil {
-			panic("[HexEqual] 'new' number parse error: " + err.Error())
+			return err
+		}
+		return checkFamily(a)
+	}
+}
+
+// String The description of the allowed values for the error messages.
+func (f IpFlags) String() string {
+	var family, forms []string
+
+	if f&IpV4 != 0 {
+		family = append(family, "IPv4")
+	}
+	if f&IpV6 != 0 {
+		family = append(family, "IPv6")
+	}
+	for _, v := range []struct {
+		flag IpFlags
+		name string
+	}{
+		{IpAddress, "address"},
+		{IpPrefix, "prefix"},
+		{IpRange, "range"},
+	} {
+		if f&v.flag != 0 {
+			forms = append(forms, v.name)
 		}
+	}
 
-		return iOld == iNew
+	res := "a valid " + strings.Join(family, "/") + " " + strings.Join(forms, " or ")
+	if f&IpNegation != 0 {
+		res = "a [negated] " + res[2:]
 	}
-)
+	if f&IpList != 0 {
+		res += " (comma-separated list)"
+	}
+	return res
+}
+
+// ValidationRoutingFilterRule Checking the structure of the RouterOS 7 routing filter rule.
+func ValidationRoutingFilterRule(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if err := ParseRoutingFilterRule(v); err != nil {
+		errors = append(errors, fmt.Errorf("invalid routing filter rule %q: %w", k, err))
+	}
+	return
+}
+
+// keepaliveEqual Compares the tunnel keepalive values 'interval[,retries]'. A missing retry count is
+// treated as the default one: '10s' == '10s,10'. Malformed values are never equal.
+func keepaliveEqual(old, new string) bool {
+	const defaultRetries = "10"
+
+	split := func(s string) (time.Duration, string, error) {
+		f := strings.Split(s, ",")
+		if len(f) > 2 {
+			return 0, "", fmt.Errorf("wrong keepalive format: '%v'", s)
+		}
+
+		retries := defaultRetries
+		if len(f) == 2 {
+			retries = f[1]
+		}
+
+		interval, err := ParseDuration(f[0])
+		return interval, retries, err
+	}
+
+	oInterval, oRetries, err := split(old)
+	if err != nil {
+		return false
+	}
+
+	nInterval, nRetries, err := split(new)
+	if err != nil {
+		return false
+	}
+
+	return oRetries == nRetries && oInterval == nInterval
+}
 
 func buildReadFilter(m map[string]interface{}) []string {
 	var res []string
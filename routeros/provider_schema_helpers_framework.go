@@ -0,0 +1,61 @@
+package routeros
+
+import (
+	"context"
+
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// The metadata fields of the framework resources are computed attributes with the default value,
+// they are stored in the state as the metadata fields of the SDK resources.
+
+// FwPropResourcePath Resource path attribute of the framework resource.
+func FwPropResourcePath(p string) fwschema.Attribute {
+	return fwschema.StringAttribute{
+		Computed:    true,
+		Default:     stringdefault.StaticString(p),
+		Description: "<em>Resource path for CRUD operations. This is an internal service field, setting a value is not required.</em>",
+	}
+}
+
+// FwPropId Resource ID type attribute of the framework resource.
+func FwPropId(t IdType) fwschema.Attribute {
+	return fwschema.Int64Attribute{
+		Computed:    true,
+		Default:     int64default.StaticInt64(int64(t)),
+		Description: "<em>Resource ID type (.id / name). This is an internal service field, setting a value is not required.</em>",
+	}
+}
+
+// FwPropIdRo The 'id' attribute of the framework resource.
+var FwPropIdRo = fwschema.StringAttribute{
+	Computed:    true,
+	Description: "The ID of this resource.",
+	PlanModifiers: []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	},
+}
+
+// FwGetMetadata Returns the metadata of the framework resource schema.
+func FwGetMetadata(ctx context.Context, s fwschema.Schema) *MikrotikItemMetadata {
+	meta := &MikrotikItemMetadata{}
+
+	if a, ok := s.Attributes[MetaResourcePath].(fwschema.StringAttribute); ok && a.Default != nil {
+		var resp defaults.StringResponse
+		a.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+		meta.Path = resp.PlanValue.ValueString()
+	}
+
+	if a, ok := s.Attributes[MetaId].(fwschema.Int64Attribute); ok && a.Default != nil {
+		var resp defaults.Int64Response
+		a.Default.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+		meta.IdType = IdType(resp.PlanValue.ValueInt64())
+	}
+
+	return meta
+}
@@ -68,3 +68,60 @@ func TestValidationMultiValInSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationIp(t *testing.T) {
+	matcherV4 := IpV4 | IpAddress | IpPrefix | IpRange | IpNegation
+	tests := []struct {
+		flags IpFlags
+		value string
+		want  int
+	}{
+		{matcherV4, "10.0.0.1", 0},
+		{matcherV4, "!10.0.0.0/8", 0},
+		{matcherV4, "10.0.0.1-10.0.0.10", 0},
+		{matcherV4, "10.0.0.10-10.0.0.1", 1},
+		{matcherV4, "2001:db8::1", 1},
+		{matcherV4, "10.0.0.256", 1},
+		{matcherV4, "", 1},
+		{IpV6 | IpAddress | IpPrefix | IpRange | IpNegation, "!2001:db8::/32", 0},
+		{IpV6 | IpAddress | IpPrefix | IpRange, "2001:db8::1-2001:db8::ff", 0},
+		{IpV6 | IpAddress, "fe80::1%ether1", 1},
+		{IpV4 | IpV6 | IpAddress, "10.0.0.1/32", 1},
+		{IpV4 | IpV6 | IpAddress | IpEmpty, "", 0},
+		{IpV4 | IpV6 | IpAddress | IpPrefix | IpList, "10.0.0.1, 2001:db8::/32", 0},
+		{IpV4 | IpV6 | IpAddress | IpPrefix | IpList, "10.0.0.1,host,10.0.0.0/33", 2},
+		{IpV4 | IpAddress | IpPrefix, "10.0.0.1,10.0.0.2", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, errs := ValidationIp(tt.flags)(tt.value, "address")
+			if len(errs) != tt.want {
+				t.Errorf("ValidationIp(%v) got %v errors, want %v: %v", tt.flags, len(errs), tt.want, errs)
+			}
+		})
+	}
+}
+
+func Test_keepaliveEqual(t *testing.T) {
+	tests := []struct {
+		old, new string
+		want     bool
+	}{
+		{"10s,10", "10s,10", true},
+		{"10s,10", "10", true},
+		{"10s,10", "10s", true},
+		{"1m,3", "60s,3", true},
+		{"10s,10", "10s,3", false},
+		{"10s,10", "20s", false},
+		{"10s,10", "10s,10,1", false},
+		{"10s,10", "bad", false},
+		{"bad", "10s", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.old+"_"+tt.new, func(t *testing.T) {
+			if got := keepaliveEqual(tt.old, tt.new); got != tt.want {
+				t.Errorf("keepaliveEqual(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
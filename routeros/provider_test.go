@@ -1,6 +1,7 @@
 package routeros
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -17,6 +19,18 @@ import (
 
 var testAccProvider *schema.Provider
 var testAccProviderFactories map[string]func() (*schema.Provider, error)
+
+// testAccProtoV5ProviderFactories The SDK and the framework providers served together, the framework
+// resources can only be tested with them.
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"routeros": func() (tfprotov5.ProviderServer, error) {
+		server, err := NewProviderServer(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return server(), nil
+	},
+}
 var testNames = []string{"API", "REST"}
 
 var reHost = regexp.MustCompile(`^(?:\S+://)?(\S+?)(?::\d+)*$`)
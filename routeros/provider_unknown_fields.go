@@ -0,0 +1,168 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Handling of the fields returned by the router that are not in the schema (the 'unknown_fields' provider option).
+const (
+	UnknownFieldsIgnore   = "ignore"
+	UnknownFieldsWarnOnce = "warn_once"
+	UnknownFieldsError    = "error"
+)
+
+// unknownFieldStep The first step of the attribute path of the unknown field diagnostics, the second step is the
+// field name. The step is not a schema attribute, so the diagnostics are identified regardless of their text.
+var unknownFieldStep = cty.GetAttrStep{Name: "___unknown_field___"}
+
+func unknownFieldSummary(name string) string {
+	return "Field '" + name + "' not found in the schema"
+}
+
+// unknownFieldDiagnostic Returns the warning that reports a field returned by the router and not found in the schema.
+func unknownFieldDiagnostic(name, detail string) diag.Diagnostic {
+	return diag.Diagnostic{
+		// TODO Waiting for TestStep.ExpectWarning https://github.com/hashicorp/terraform-plugin-testing/pull/17
+		// The test response to Warnings has not yet been implemented.
+		Severity:      diag.Warning,
+		Summary:       unknownFieldSummary(name),
+		Detail:        detail,
+		AttributePath: cty.Path{unknownFieldStep, cty.GetAttrStep{Name: name}},
+	}
+}
+
+// unknownFieldName Returns the name of the field if the diagnostic reports an unknown field.
+func unknownFieldName(d diag.Diagnostic) (string, bool) {
+	if d.Severity != diag.Warning || len(d.AttributePath) != 2 || d.AttributePath[0] != unknownFieldStep {
+		return "", false
+	}
+	step, ok := d.AttributePath[1].(cty.GetAttrStep)
+	return step.Name, ok
+}
+
+// unknownFieldFwPath The framework equivalent of the attribute path of the unknown field diagnostics.
+func unknownFieldFwPath(name string) path.Path {
+	return path.Root(unknownFieldStep.Name).AtName(name)
+}
+
+// unknownFieldFwName Returns the name of the field if the framework diagnostic reports an unknown field.
+func unknownFieldFwName(d fwdiag.Diagnostic) (string, bool) {
+	dp, ok := d.(fwdiag.DiagnosticWithPath)
+	if !ok || d.Severity() != fwdiag.SeverityWarning {
+		return "", false
+	}
+	steps := dp.Path().Steps()
+	if len(steps) != 2 || !steps[0].Equal(path.PathStepAttributeName(unknownFieldStep.Name)) {
+		return "", false
+	}
+	name, ok := steps[1].(path.PathStepAttributeName)
+	return string(name), ok
+}
+
+// unknownFieldsReport The unknown fields are reported once per resource type for the provider instance.
+type unknownFieldsReport struct {
+	sync.Mutex
+	reported map[string]struct{}
+}
+
+// firstTime Returns true only the first time for each resource type.
+func (r *unknownFieldsReport) firstTime(resourceType string) bool {
+	if r == nil {
+		return true
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.reported == nil {
+		r.reported = make(map[string]struct{})
+	}
+	if _, ok := r.reported[resourceType]; ok {
+		return false
+	}
+	r.reported[resourceType] = struct{}{}
+	return true
+}
+
+// filterUnknownFields Replacing the per-field warnings with a single diagnostic according to the provider option.
+func (o *ClientOptions) filterUnknownFields(resourceType string, diags diag.Diagnostics) diag.Diagnostics {
+	var res diag.Diagnostics
+	var fields []string
+	var seen = make(map[string]struct{})
+
+	for _, d := range diags {
+		name, ok := unknownFieldName(d)
+		if !ok {
+			res = append(res, d)
+			continue
+		}
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			fields = append(fields, name)
+		}
+	}
+
+	if len(fields) == 0 {
+		return diags
+	}
+	sort.Strings(fields)
+
+	switch o.UnknownFields {
+	case UnknownFieldsIgnore:
+	case UnknownFieldsError:
+		res = append(res, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unknown fields in %v", resourceType),
+			Detail: fmt.Sprintf("The router returned fields that are not in the schema of %v: %v. "+
+				"Check the RouterOS version or set the provider option unknown_fields to 'warn_once'.",
+				resourceType, strings.Join(fields, ", ")),
+		})
+	default:
+		if o.unknownFieldsReport.firstTime(resourceType) {
+			res = append(res, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unknown fields in %v", resourceType),
+				Detail: fmt.Sprintf("The router returned fields that are not in the schema of %v: %v. "+
+					"These fields are ignored, this warning is shown once per resource type.",
+					resourceType, strings.Join(fields, ", ")),
+			})
+		}
+	}
+
+	return res
+}
+
+func unknownFieldsContextFunc(resourceType string,
+	f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if c, ok := m.(Client); ok {
+			return c.GetOptions().filterUnknownFields(resourceType, diags)
+		}
+		return diags
+	}
+}
+
+// applyUnknownFieldsPolicy Wrapping the resource functions to handle the unknown fields according to the provider option.
+func applyUnknownFieldsPolicy(resourceType string, r *schema.Resource) {
+	if r.CreateContext != nil {
+		r.CreateContext = schema.CreateContextFunc(unknownFieldsContextFunc(resourceType, r.CreateContext))
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = schema.ReadContextFunc(unknownFieldsContextFunc(resourceType, r.ReadContext))
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = schema.UpdateContextFunc(unknownFieldsContextFunc(resourceType, r.UpdateContext))
+	}
+}
@@ -0,0 +1,47 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func Test_filterUnknownFields(t *testing.T) {
+	diags := diag.Diagnostics{
+		unknownFieldDiagnostic("new-field", ""),
+		unknownFieldDiagnostic("another-field", ""),
+		unknownFieldDiagnostic("new-field", ""),
+		{Severity: diag.Warning, Summary: "Some other warning"},
+	}
+
+	// The same text without the attribute path is not an unknown field.
+	if _, ok := unknownFieldName(diag.Diagnostic{Severity: diag.Warning, Summary: unknownFieldSummary("new-field")}); ok {
+		t.Error("the diagnostic must be identified by the attribute path")
+	}
+
+	o := &ClientOptions{UnknownFields: UnknownFieldsIgnore}
+	if res := o.filterUnknownFields("routeros_test", diags); len(res) != 1 || res[0].Summary != "Some other warning" {
+		t.Errorf("ignore: unexpected diagnostics %v", res)
+	}
+
+	o = &ClientOptions{UnknownFields: UnknownFieldsError}
+	res := o.filterUnknownFields("routeros_test", diags)
+	if !res.HasError() || len(res) != 2 {
+		t.Fatalf("error: unexpected diagnostics %v", res)
+	}
+	if res[1].Detail != "The router returned fields that are not in the schema of routeros_test: another-field, new-field. "+
+		"Check the RouterOS version or set the provider option unknown_fields to 'warn_once'." {
+		t.Errorf("error: unexpected detail %q", res[1].Detail)
+	}
+
+	o = &ClientOptions{UnknownFields: UnknownFieldsWarnOnce, unknownFieldsReport: &unknownFieldsReport{}}
+	if res := o.filterUnknownFields("routeros_test", diags); len(res) != 2 || res.HasError() {
+		t.Errorf("warn_once: unexpected diagnostics %v", res)
+	}
+	if res := o.filterUnknownFields("routeros_test", diags); len(res) != 1 {
+		t.Errorf("warn_once: the warning must be shown once per resource type, got %v", res)
+	}
+	if res := o.filterUnknownFields("routeros_other", diags); len(res) != 2 {
+		t.Errorf("warn_once: unexpected diagnostics for another resource type %v", res)
+	}
+}
@@ -68,6 +68,7 @@ func ResourceRoutingBGPConnection() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/routing/bgp/connection"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"keepalive_time":"duration"`),
 
 		"add_path_out": {
 			Type:         schema.TypeString,
@@ -254,11 +255,10 @@ func ResourceRoutingBGPConnection() *schema.Resource {
 			},
 		},
 		"keepalive_time": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Default:          "3m",
-			Description:      "How long to keep the BGP session open after the last received 'keepalive' message.",
-			DiffSuppressFunc: TimeEquall,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "3m",
+			Description: "How long to keep the BGP session open after the last received 'keepalive' message.",
 		},
 		"listen": {
 			Type:        schema.TypeBool,
@@ -538,7 +538,7 @@ func ResourceRoutingBGPConnection() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
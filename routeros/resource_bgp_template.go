@@ -56,6 +56,7 @@ func ResourceRoutingBGPTemplate() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/routing/bgp/template"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"keepalive_time":"duration"`),
 
 		"add_path_out": {
 			Type:         schema.TypeString,
@@ -233,11 +234,10 @@ func ResourceRoutingBGPTemplate() *schema.Resource {
 			},
 		},
 		"keepalive_time": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Default:          "3m",
-			Description:      "How long to keep the BGP session open after the last received 'keepalive' message.",
-			DiffSuppressFunc: TimeEquall,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "3m",
+			Description: "How long to keep the BGP session open after the last received 'keepalive' message.",
 		},
 		"multihop": {
 			Type:     schema.TypeBool,
@@ -409,7 +409,7 @@ func ResourceRoutingBGPTemplate() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -39,6 +39,7 @@ func ResourceCapsManConfiguration() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/caps-man/configuration"),
 		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"disconnect_timeout":"duration","frame_lifetime":"duration"`),
 		MetaTransformSet: PropTransformSet(`"channel": "channel.config", "datapath": "datapath.config",
 		"rates": "rates.config", "security": "security.config"`),
 
@@ -73,7 +74,6 @@ func ResourceCapsManConfiguration() *schema.Resource {
 				"transmission will be retried with on-fail-retry-time interval. If no frame can be transmitted successfully " +
 				`during disconnect-timeout, the connection is closed, and this event is logged as "extensive data loss". ` +
 				"Successful frame transmission resets this timer.",
-			DiffSuppressFunc: TimeEquall,
 		},
 		"distance": {
 			Type:     schema.TypeString,
@@ -86,7 +86,6 @@ func ResourceCapsManConfiguration() *schema.Resource {
 			Optional: true,
 			Description: "Discard frames that have been queued for sending longer than frame-lifetime. By default, when " +
 				"value of this property is 0, frames are discarded only after connection is closed (format: 0.00 sec).",
-			DiffSuppressFunc: TimeEquall,
 		},
 		"guard_interval": {
 			Type:     schema.TypeString,
@@ -208,7 +207,7 @@ func ResourceCapsManConfiguration() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -23,6 +23,8 @@ func ResourceCapsManManager() *schema.Resource {
 		MetaResourcePath: PropResourcePath("/caps-man/manager"),
 		MetaId:           PropId(Name),
 
+		KeyResetOnDestroy: PropResetOnDestroy,
+
 		"ca_certificate": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -61,11 +63,18 @@ func ResourceCapsManManager() *schema.Resource {
 		},
 	}
 
+	// Factory defaults of the fields without a default value in the schema.
+	resetDefaults := MikrotikItem{
+		"enabled":                  "no",
+		"package-path":             "",
+		"require-peer-certificate": "no",
+	}
+
 	return &schema.Resource{
 		CreateContext: DefaultSystemCreate(resSchema),
 		ReadContext:   DefaultSystemRead(resSchema),
 		UpdateContext: DefaultSystemUpdate(resSchema),
-		DeleteContext: DefaultSystemDelete(resSchema),
+		DeleteContext: DefaultSystemResetDelete(resSchema, resetDefaults),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -115,7 +124,7 @@ func ResourceCapsManManagerInterface() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -138,6 +147,7 @@ func ResourceCapsManAaa() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/caps-man/aaa"),
 		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"interim_update":"duration","mac_caching":"duration"`),
 
 		"called_format": {
 			Type:     schema.TypeString,
@@ -154,7 +164,6 @@ func ResourceCapsManAaa() *schema.Resource {
 			Description: "When RADIUS accounting is used, Access Point periodically sends accounting information " +
 				"updates to the RADIUS server. This property specifies the default update interval that can be " +
 				"overridden by the RADIUS server using the Acct-Interim-Interval attribute.",
-			//DiffSuppressFunc: TimeEquall, // "interim-update": "disabled"
 		},
 		"mac_caching": {
 			Type:     schema.TypeString,
@@ -163,7 +172,6 @@ func ResourceCapsManAaa() *schema.Resource {
 			Description: "If this value is set to a time interval, the Access Point will cache RADIUS MAC authentication " +
 				"responses for a specified time, and will not contact the RADIUS server if matching cache entry already " +
 				"exists. The value disabled will disable the cache, Access Point will always contact the RADIUS server.",
-			//DiffSuppressFunc: TimeEquall, // "mac-caching": "disabled"
 		},
 		"mac_format": {
 			Type:     schema.TypeString,
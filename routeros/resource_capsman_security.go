@@ -28,6 +28,7 @@ func ResourceCapsManSecurity() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/caps-man/security"),
 		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"group_key_update":"duration"`),
 
 		"authentication_types": {
 			Type:        schema.TypeSet,
@@ -81,7 +82,6 @@ func ResourceCapsManSecurity() *schema.Resource {
 			Optional: true,
 			Description: "Controls how often Access Point updates the group key. This key is used to encrypt all " +
 				"broadcast and multicast frames. property only has effect for Access Points. (30s..1h)",
-			DiffSuppressFunc: TimeEquall,
 		},
 		KeyName: PropNameForceNewRw,
 		// 802.11i specification:
@@ -116,7 +116,7 @@ func ResourceCapsManSecurity() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
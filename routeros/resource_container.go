@@ -0,0 +1,382 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "arch": "arm64",
+    "comment": "",
+    "envlist": "pihole",
+    "hostname": "pihole",
+    "interface": "veth1",
+    "logging": "true",
+    "mounts": "pihole-etc,pihole-dnsmasq",
+    "name": "6c2a4f3d-4a0c-4b6e-8d3b-cd3a5b2c6f10",
+    "os": "linux",
+    "root-dir": "disk1/pihole",
+    "start-on-boot": "true",
+    "status": "stopped",
+    "tag": "pihole/pihole:latest"
+  }
+*/
+
+// The container statuses.
+const (
+	containerExtracting = "extracting"
+	containerStarting   = "starting"
+	containerRunning    = "running"
+	containerStopping   = "stopping"
+	containerStopped    = "stopped"
+	containerError      = "error"
+)
+
+// containerPollInterval The interval of the container status polling while waiting for the asynchronous operations.
+var containerPollInterval = 3 * time.Second
+
+// ResourceContainer https://help.mikrotik.com/docs/display/ROS/Container
+func ResourceContainer() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/container"),
+		MetaId:           PropId(Id),
+		MetaReferences: PropReferences(`"interface":"/interface/veth","envlist":"/container/envs:name",
+			"mounts":"/container/mounts"`),
+		MetaSkipFields: PropSkipFields(`"start_on_create"`),
+
+		"arch": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The architecture of the container image.",
+		},
+		"cmd": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The main purpose of a CMD is to provide defaults for an executing container.",
+		},
+		KeyComment: PropCommentRw,
+		"dns": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Set custom DNS servers.",
+		},
+		"domain_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Container NIS domain name.",
+		},
+		"entrypoint": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An ENTRYPOINT allows to specify executable to run when starting container.",
+		},
+		"envlist": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the environment variables list (`routeros_container_envs`).",
+		},
+		"file": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "The container image tar file on the router to use instead of the remote image.",
+			ExactlyOneOf: []string{"file", "remote_image"},
+		},
+		"hostname": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The hostname of the container.",
+		},
+		"interface": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The veth interface of the container.",
+		},
+		"logging": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If set to yes, all container-generated output will be shown in the RouterOS log.",
+		},
+		"mounts": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "The names of the mounts (`routeros_container_mounts`) to use in the container.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		KeyName: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the container assigned by the router.",
+		},
+		"os": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The OS of the container image.",
+		},
+		"remote_image": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Description: "The container image name to be pulled from the registry (`routeros_container_config`): " +
+				"`pihole/pihole:latest`.",
+		},
+		"root_dir": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Used to save container store outside main memory.",
+		},
+		"start_on_boot": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Start the container on boot.",
+		},
+		"start_on_create": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "Start the container as soon as the image is extracted. This is a provider setting, it " +
+				"is not stored on the router.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the container: `extracting`, `stopped`, `starting`, `running`, `stopping`, `error`.",
+		},
+		"stop_signal": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Signal to stop the container.",
+		},
+		"tag": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The image name and tag of the container.",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Sets the username used and optionally the groupname (UID:GID) for the container.",
+		},
+		"workdir": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The working directory for cmd entrypoint.",
+		},
+	}
+
+	resCreate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		item, metadata := TerraformResourceDataToMikrotik(resSchema, d)
+
+		// The IDs of the existing containers, the new container may be created after the response to the 'add'.
+		before, err := ReadItemsOrder(metadata.Path, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+			return diag.FromErr(err)
+		}
+
+		res, err := CreateItem(item, metadata.Path, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+			return diag.FromErr(err)
+		}
+
+		id := res.GetID(Id)
+		if id == "" {
+			if id, err = containerWaitCreated(ctx, metadata.Path, before, m.(Client)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		d.SetId(id)
+
+		// The image is downloaded and extracted in the background.
+		status, err := containerWaitStatus(ctx, d.Id(), m.(Client), containerExtracting)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if status == containerError {
+			return diag.Errorf("the container image extraction failed, see the router log for details")
+		}
+
+		if d.Get("start_on_create").(bool) {
+			if diags := containerStart(ctx, d.Id(), m.(Client)); diags.HasError() {
+				return diags
+			}
+		}
+
+		return ResourceRead(ctx, resSchema, d, m)
+	}
+
+	resUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// Only the stopped container can be changed.
+		running := d.Get("status").(string) == containerRunning
+		if running {
+			if diags := containerStop(ctx, d.Id(), m.(Client)); diags.HasError() {
+				return diags
+			}
+		}
+
+		if diags := ResourceUpdate(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
+
+		if running {
+			if diags := containerStart(ctx, d.Id(), m.(Client)); diags.HasError() {
+				return diags
+			}
+		}
+
+		return ResourceRead(ctx, resSchema, d, m)
+	}
+
+	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		status, err := containerWaitStatus(ctx, d.Id(), m.(Client), containerExtracting, containerStarting)
+		if err != nil && err != errorNoLongerExists {
+			return diag.FromErr(err)
+		}
+
+		// Only the stopped container can be removed.
+		if status == containerRunning {
+			if diags := containerStop(ctx, d.Id(), m.(Client)); diags.HasError() {
+				return diags
+			}
+		}
+
+		return ResourceDelete(ctx, resSchema, d, m)
+	}
+
+	return &schema.Resource{
+		CreateContext: resCreate,
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: resUpdate,
+		DeleteContext: resDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: resSchema,
+	}
+}
+
+// containerStatus Returns the status of the container, older RouterOS versions only report the flags.
+func containerStatus(item MikrotikItem) string {
+	if status, ok := item["status"]; ok {
+		return status
+	}
+
+	for _, status := range []string{containerExtracting, containerStarting, containerRunning, containerStopping,
+		containerError} {
+		if item[status] == "true" {
+			return status
+		}
+	}
+
+	return containerStopped
+}
+
+// containerWaitStatus Waiting for the container to leave the transitional statuses. Returns the last status.
+// The wait is limited by the context deadline (the resource timeouts).
+func containerWaitStatus(ctx context.Context, id string, c Client, pending ...string) (string, error) {
+	for {
+		res, err := ReadItems(&ItemId{Id, id}, "/container", c)
+		if err != nil {
+			return "", err
+		}
+
+		if len(*res) == 0 {
+			return "", errorNoLongerExists
+		}
+
+		status := containerStatus((*res)[0])
+
+		var wait bool
+		for _, s := range pending {
+			wait = wait || status == s
+		}
+
+		if !wait {
+			return status, nil
+		}
+
+		ColorizedDebug(ctx, fmt.Sprintf("container '%v' is %v, waiting...", id, status))
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("timeout while waiting for the container '%v': the status is '%v'", id, status)
+		case <-time.After(containerPollInterval):
+		}
+	}
+}
+
+// containerWaitCreated Waiting for the container that was not in the 'before' list to appear.
+func containerWaitCreated(ctx context.Context, path string, before []string, c Client) (string, error) {
+	existing := make(map[string]struct{}, len(before))
+	for _, id := range before {
+		existing[id] = struct{}{}
+	}
+
+	for {
+		ids, err := ReadItemsOrder(path, c)
+		if err != nil {
+			return "", err
+		}
+
+		for _, id := range ids {
+			if _, ok := existing[id]; !ok {
+				return id, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("timeout while waiting for the container to be created")
+		case <-time.After(containerPollInterval):
+		}
+	}
+}
+
+func containerStart(ctx context.Context, id string, c Client) diag.Diagnostics {
+	if err := StartItem(&ItemId{Id, id}, "/container", c); err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, err := containerWaitStatus(ctx, id, c, containerStarting)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status != containerRunning {
+		return diag.Errorf("the container '%v' failed to start: the status is '%v', see the router log for details",
+			id, status)
+	}
+
+	return nil
+}
+
+func containerStop(ctx context.Context, id string, c Client) diag.Diagnostics {
+	if err := StopItem(&ItemId{Id, id}, "/container", c); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := containerWaitStatus(ctx, id, c, containerRunning, containerStopping); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    "layer-dir": "",
+    "ram-high": "0",
+    "registry-url": "https://registry-1.docker.io",
+    "tmpdir": "disk1/pull",
+    "username": ""
+  }
+*/
+
+// ResourceContainerConfig https://help.mikrotik.com/docs/display/ROS/Container#Container-Containerconfiguration
+func ResourceContainerConfig() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/container/config"),
+		MetaId:           PropId(Name),
+		MetaSecrets:      PropSecrets(`"password"`),
+
+		"layer_dir": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The directory of the image layers cache.",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The password for the registry.",
+		},
+		"ram_high": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "RAM usage limit for all containers: `200.0MiB`. `0` for unlimited. If the RAM usage goes " +
+				"over the high boundary, the processes of the container are throttled and put under heavy " +
+				"reclaim pressure.",
+		},
+		"registry_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "External registry URL from where the container will be downloaded.",
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"tmpdir": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Container extraction directory.",
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The username for the registry.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultSystemCreate(resSchema),
+		ReadContext:   DefaultSystemRead(resSchema),
+		UpdateContext: DefaultSystemUpdate(resSchema),
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
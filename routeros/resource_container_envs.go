@@ -0,0 +1,52 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "key": "TZ",
+    "name": "pihole",
+    "value": "Europe/Riga"
+  }
+*/
+
+// ResourceContainerEnvs https://help.mikrotik.com/docs/display/ROS/Container#Container-Environmentvariablesandmounts
+func ResourceContainerEnvs() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/container/envs"),
+		MetaId:           PropId(Id),
+
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the environment variable.",
+		},
+		KeyName: {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "The name of the environment variables list. The variables with the same name form " +
+				"the list that is referenced by the `envlist` of the container.",
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The value of the environment variable.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
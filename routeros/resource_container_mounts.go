@@ -0,0 +1,48 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "dst": "/etc/pihole",
+    "name": "pihole-etc",
+    "src": "/disk1/pihole/etc"
+  }
+*/
+
+// ResourceContainerMounts https://help.mikrotik.com/docs/display/ROS/Container#Container-Environmentvariablesandmounts
+func ResourceContainerMounts() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/container/mounts"),
+		MetaId:           PropId(Name),
+
+		KeyComment: PropCommentRw,
+		"dst": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The destination path of the mount inside the container.",
+		},
+		KeyName: PropName("The name of the mount."),
+		"src": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The source path of the mount on the router, the directory is created if it does not exist.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
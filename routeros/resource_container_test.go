@@ -0,0 +1,102 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testContainerAddress       = "routeros_container.test"
+	testContainerEnvsAddress   = "routeros_container_envs.test"
+	testContainerMountsAddress = "routeros_container_mounts.test"
+)
+
+func TestAccContainerTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/container", "routeros_container"),
+					testCheckResourceDestroy("/container/envs", "routeros_container_envs"),
+					testCheckResourceDestroy("/container/mounts", "routeros_container_mounts"),
+					testCheckResourceDestroy("/interface/veth", "routeros_interface_veth"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccContainerConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckContainerExists(testContainerAddress),
+							testAccCheckContainerExists(testContainerEnvsAddress),
+							testAccCheckContainerExists(testContainerMountsAddress),
+							resource.TestCheckResourceAttr(testContainerAddress, "status", "running"),
+							resource.TestCheckResourceAttr(testContainerAddress, "envlist", "test_container_envs"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckContainerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerConfig() string {
+	return providerConfig + `
+
+resource "routeros_container_config" "test" {
+	registry_url = "https://registry-1.docker.io"
+	tmpdir       = "pull"
+}
+
+resource "routeros_interface_veth" "test" {
+	name    = "test_container_veth"
+	address = "172.17.0.2/24"
+	gateway = "172.17.0.1"
+}
+
+resource "routeros_container_envs" "test" {
+	name  = "test_container_envs"
+	key   = "TZ"
+	value = "UTC"
+}
+
+resource "routeros_container_mounts" "test" {
+	name = "test_container_mounts"
+	src  = "/test_container/data"
+	dst  = "/data"
+}
+
+resource "routeros_container" "test" {
+	remote_image    = "library/alpine:latest"
+	interface       = routeros_interface_veth.test.name
+	envlist         = routeros_container_envs.test.name
+	mounts          = [routeros_container_mounts.test.name]
+	root_dir        = "test_container/root"
+	cmd             = "sleep infinity"
+	start_on_create = true
+
+	depends_on = [routeros_container_config.test]
+}
+`
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -33,9 +35,235 @@ func dynamicIdLookup(idType IdType, path string, c Client, d *schema.ResourceDat
 	return (*res)[0].GetID(Id), nil
 }
 
+// resourceIdType Returns the ID type of the resource. The 'CommentTag' type is only used if the 'comment_tag_id'
+// option of the provider is enabled or the resource is already tagged, otherwise the item is identified by '.id'.
+func resourceIdType(idType IdType, d *schema.ResourceData, c Client) IdType {
+	if idType == CommentTag && !c.GetOptions().CommentTagId && !isCommentTag(d.Id()) {
+		return Id
+	}
+	return idType
+}
+
+// commentTagItem Adding the tag of the resource to the comment of the item.
+// Resources that were created or imported by the '.id' get a new tag on the first update.
+func commentTagItem(item MikrotikItem, d *schema.ResourceData) (string, error) {
+	tag := d.Id()
+	if !isCommentTag(tag) {
+		var err error
+		if tag, err = newCommentTag(); err != nil {
+			return "", err
+		}
+	}
+
+	// The comment is sent only if it has been changed or the item is not tagged yet.
+	if _, ok := item[KeyComment]; ok || tag != d.Id() {
+		item[KeyComment] = joinCommentTag(tag, d.Get(KeyComment).(string))
+	}
+
+	return tag, nil
+}
+
+// staticItems Filtering out the dynamic items, they are created by the router and are not managed.
+func staticItems(items []MikrotikItem) []MikrotikItem {
+	var res = make([]MikrotikItem, 0, len(items))
+	for _, item := range items {
+		if !BoolFromMikrotikJSON(item[KeyDynamic]) {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// nestedBlocksList Returns the blocks of the list or set field.
+func nestedBlocksList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// nestedItemsIds Returns the IDs of the sub-path items recorded in the state of the field.
+func nestedItemsIds(v interface{}) []string {
+	var res []string
+	for _, block := range nestedBlocksList(v) {
+		if b, ok := block.(map[string]interface{}); ok && b["id"] != nil && b["id"].(string) != "" {
+			res = append(res, b["id"].(string))
+		}
+	}
+	return res
+}
+
+// nestedItemsRecorded Reading the sub-path items linked to the resource that are recorded in the state.
+// Other items (dynamic ones or the items managed by other resources) are ignored.
+func nestedItemsRecorded(ids []string, enc *NestedEncoding, d *schema.ResourceData, c Client) ([]MikrotikItem, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	res, err := ReadItemsFiltered([]string{enc.Link + "=" + d.Id()}, enc.Path, c)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]MikrotikItem)
+	for _, item := range staticItems(*res) {
+		existing[item.GetID(Id)] = item
+	}
+
+	var items []MikrotikItem
+	for _, id := range ids {
+		if item, ok := existing[id]; ok {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// nestedItemsRead Reading the sub-path items linked to the resource.
+func nestedItemsRead(s map[string]*schema.Schema, d *schema.ResourceData, c Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, enc := range getNestedBlocks(s) {
+		if enc.Type != NestedItems {
+			continue
+		}
+
+		items, err := nestedItemsRecorded(nestedItemsIds(d.Get(name)), enc, d, c)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		diags = append(diags, MikrotikNestedItemsToTerraform(items, name, s, d)...)
+	}
+
+	return diags
+}
+
+// nestedItemsDelete Deleting the sub-path items linked to the resource that are recorded in the state.
+// If the field name is not empty, only the items of this field are deleted.
+func nestedItemsDelete(field string, s map[string]*schema.Schema, d *schema.ResourceData, c Client) error {
+	for name, enc := range getNestedBlocks(s) {
+		if enc.Type != NestedItems || (field != "" && field != name) {
+			continue
+		}
+
+		old, _ := d.GetChange(name)
+		items, err := nestedItemsRecorded(nestedItemsIds(old), enc, d, c)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err = DeleteItem(&ItemId{Id, item.GetID(Id)}, enc.Path, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// nestedItemsSync Replacing the sub-path items linked to the resource with the items from the nested blocks.
+// The items are only recreated if the nested blocks have been changed.
+func nestedItemsSync(s map[string]*schema.Schema, d *schema.ResourceData, c Client) error {
+	encodings := getNestedBlocks(s)
+
+	for name, items := range TerraformNestedItems(s, d) {
+		if !d.IsNewResource() && !d.HasChange(name) {
+			continue
+		}
+
+		if err := nestedItemsDelete(name, s, d, c); err != nil {
+			return err
+		}
+
+		enc := encodings[name]
+		config := nestedBlocksList(d.Get(name))
+		var blocks = make([]interface{}, 0, len(items))
+		for i, item := range items {
+			item[enc.Link] = d.Id()
+			res, err := CreateItem(item, enc.Path, c)
+			if err != nil {
+				return err
+			}
+
+			// The ID of the created item is recorded in the state.
+			block := map[string]interface{}{}
+			for k, v := range config[i].(map[string]interface{}) {
+				block[k] = v
+			}
+			block["id"] = res.GetID(Id)
+			blocks = append(blocks, block)
+		}
+
+		if err := d.Set(name, blocks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// placeBeforeRead Checking the position of the item relative to the 'place_before' item.
+// If the item was moved outside of Terraform, the field is cleared in the state, so the next plan
+// will contain an in-place update that moves the item back. Only the relative order is checked: other items
+// placed before the same item are legitimately located between the item and the 'place_before' item.
+func placeBeforeRead(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, c Client) error {
+	if _, ok := s[KeyPlaceBefore]; !ok {
+		return nil
+	}
+
+	destination := d.Get(KeyPlaceBefore).(string)
+	if destination == "" {
+		return nil
+	}
+
+	metadata := GetMetadata(s)
+
+	// The order of the menu is read once for all items of the menu during the refresh.
+	order, err := ReadItemsOrderCached(metadata.Path, c)
+	if err != nil {
+		return err
+	}
+
+	var itemPos, destinationPos = -1, -1
+	for i, id := range order {
+		switch id {
+		case d.Id():
+			itemPos = i
+		case destination:
+			destinationPos = i
+		}
+	}
+
+	// The destination item no longer exists, there is nothing to compare with.
+	if itemPos < 0 || destinationPos < 0 {
+		return nil
+	}
+
+	if itemPos > destinationPos {
+		ColorizedDebug(ctx, fmt.Sprintf("the item '%v' was moved after the item '%v'", d.Id(), destination))
+		return d.Set(KeyPlaceBefore, "")
+	}
+
+	return nil
+}
+
 // ResourceCreate Creation of a resource in accordance with the TF Schema.
 func ResourceCreate(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	item, metadata := TerraformResourceDataToMikrotik(s, d)
+	metadata.IdType = resourceIdType(metadata.IdType, d, m.(Client))
+
+	var tag string
+	var err error
+	if metadata.IdType == CommentTag {
+		if tag, err = commentTagItem(item, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	res, err := CreateItem(item, metadata.Path, m.(Client))
 	if err != nil {
@@ -63,6 +291,8 @@ func ResourceCreate(ctx context.Context, s map[string]*schema.Schema, d *schema.
 	case Name:
 		// Resource ID.
 		d.SetId(item.GetID(Name))
+	case CommentTag:
+		d.SetId(tag)
 	}
 
 	// We ask for information again in the case of API.
@@ -86,13 +316,24 @@ func ResourceCreate(ctx context.Context, s map[string]*schema.Schema, d *schema.
 		res = (*r)[0]
 	}
 
+	if err = updateSecretHashes(s, d); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+		return diag.FromErr(err)
+	}
+
+	if err = nestedItemsSync(s, d, m.(Client)); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+		return diag.FromErr(err)
+	}
+
 	//spew.Dump(res)
-	return MikrotikResourceDataToTerraform(res, s, d)
+	return append(MikrotikResourceDataToTerraform(res, s, d), nestedItemsRead(s, d, m.(Client))...)
 }
 
 // ResourceRead Reading some information about one specific resource.
 func ResourceRead(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	metadata := GetMetadata(s)
+	metadata.IdType = resourceIdType(metadata.IdType, d, m.(Client))
 
 	res, err := ReadItems(&ItemId{metadata.IdType, d.Id()}, metadata.Path, m.(Client))
 	if err != nil {
@@ -108,12 +349,21 @@ func ResourceRead(ctx context.Context, s map[string]*schema.Schema, d *schema.Re
 
 	d.SetId((*res)[0].GetID(metadata.IdType))
 
-	return MikrotikResourceDataToTerraform((*res)[0], s, d)
+	if err = placeBeforeRead(ctx, s, d, m.(Client)); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+		return diag.FromErr(err)
+	}
+
+	return append(MikrotikResourceDataToTerraform((*res)[0], s, d), nestedItemsRead(s, d, m.(Client))...)
 }
 
 // ResourceUpdate Updating the resource in accordance with the TF Schema.
 func ResourceUpdate(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	item, metadata := TerraformResourceDataToMikrotik(s, d)
+	metadata.IdType = resourceIdType(metadata.IdType, d, m.(Client))
+
+	// The position of the item can only be changed with the 'move' command.
+	delete(item, SnakeToKebab(KeyPlaceBefore))
 
 	// d.Id() can be the name of a resource or its identifier.
 	// Mikrotik only operates on resource ID!
@@ -125,18 +375,48 @@ func ResourceUpdate(ctx context.Context, s map[string]*schema.Schema, d *schema.
 		return diag.FromErr(err)
 	}
 
+	var tag string
+	if metadata.IdType == CommentTag {
+		if tag, err = commentTagItem(item, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	res, err := UpdateItem(&ItemId{Id, id}, metadata.Path, item, m.(Client))
 	if err != nil {
 		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraform(res, s, d)
+	if metadata.IdType == CommentTag {
+		d.SetId(tag)
+	}
+
+	if err = updateSecretHashes(s, d); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+		return diag.FromErr(err)
+	}
+
+	if err = nestedItemsSync(s, d, m.(Client)); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+		return diag.FromErr(err)
+	}
+
+	// Removing the 'place_before' value does not change the position of the item.
+	if destination, ok := d.Get(KeyPlaceBefore).(string); ok && destination != "" && d.HasChange(KeyPlaceBefore) {
+		if err = MoveItem(&ItemId{Id, id}, destination, metadata.Path, m.(Client)); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(err)
+		}
+	}
+
+	return append(MikrotikResourceDataToTerraform(res, s, d), nestedItemsRead(s, d, m.(Client))...)
 }
 
 // ResourceDelete Deleting the resource.
 func ResourceDelete(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	metadata := GetMetadata(s)
+	metadata.IdType = resourceIdType(metadata.IdType, d, m.(Client))
 
 	id, err := dynamicIdLookup(metadata.IdType, metadata.Path, m.(Client), d)
 	if err != nil {
@@ -155,6 +435,11 @@ func ResourceDelete(ctx context.Context, s map[string]*schema.Schema, d *schema.
 		}
 	}
 
+	if err := nestedItemsDelete("", s, d, m.(Client)); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+		return diag.FromErr(err)
+	}
+
 	if err := DeleteItem(&ItemId{Id, id}, metadata.Path, m.(Client)); err != nil {
 		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
 		return diag.FromErr(err)
@@ -164,12 +449,267 @@ func ResourceDelete(ctx context.Context, s map[string]*schema.Schema, d *schema.
 	return nil
 }
 
+// ImportStateCustomContext Import of a resource by the internal ID, the name or by a query on the natural key.
+// The query is a comma-separated list of fields in the TF (snake) notation: name=foo, comment=bar,
+// chain=input,comment=ssh. The query must match exactly one item, the resource ID is then stored according
+// to the 'MetaId' field of the schema.
+func ImportStateCustomContext(s map[string]*schema.Schema) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		// *1A, ether1
+		if !strings.Contains(d.Id(), "=") {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		metadata := GetMetadata(s)
+		metadata.IdType = resourceIdType(metadata.IdType, d, m.(Client))
+
+		query, err := parseImportQuery(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		var filter []string
+		for _, f := range query {
+			switch f[0] {
+			case "id":
+				f[0] = Id.String()
+			default:
+				if _, ok := s[f[0]]; !ok {
+					return nil, fmt.Errorf("the field '%v' of the import query was not found in the schema", f[0])
+				}
+				f[0] = SnakeToKebab(f[0])
+			}
+
+			filter = append(filter, f[0]+"="+f[1])
+		}
+
+		res, err := ReadItemsFiltered(filter, metadata.Path, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+			return nil, err
+		}
+
+		switch len(*res) {
+		case 0:
+			return nil, fmt.Errorf("no items were found in '%v' matching the query '%v'", metadata.Path, d.Id())
+		case 1:
+		default:
+			var ids []string
+			for _, item := range *res {
+				ids = append(ids, item.GetID(Id))
+			}
+			return nil, fmt.Errorf("the query '%v' is ambiguous, %v items were found in '%v': %v",
+				d.Id(), len(*res), metadata.Path, strings.Join(ids, ", "))
+		}
+
+		d.SetId((*res)[0].GetID(metadata.IdType))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// parseImportQuery Splitting the import query into the key-value pairs: key=value[,key=value].
+// The value can be enclosed in double quotes to contain commas: comment="Office, VPN" (\" and \\ are escaped).
+// An unquoted part without '=' continues the previous value, so comment=Office, VPN is also a single pair.
+func parseImportQuery(id string) ([][2]string, error) {
+	var res [][2]string
+	var lastQuoted bool
+	var errFormat = fmt.Errorf("wrong import query format: '%v', expected 'key=value[,key=value]'", id)
+
+	for rest := id; rest != ""; {
+		var part string
+
+		f := strings.SplitN(rest, "=", 2)
+		key := strings.TrimSpace(f[0])
+
+		if len(f) == 2 && strings.HasPrefix(strings.TrimLeft(f[1], " "), `"`) && key != "" && !strings.Contains(key, ",") {
+			// key="value, with commas"
+			v := strings.TrimLeft(f[1], " ")[1:]
+			var value strings.Builder
+			var closed bool
+			var i int
+			for ; i < len(v); i++ {
+				if v[i] == '\\' && i+1 < len(v) {
+					i++
+					value.WriteByte(v[i])
+					continue
+				}
+				if v[i] == '"' {
+					closed = true
+					break
+				}
+				value.WriteByte(v[i])
+			}
+			if !closed {
+				return nil, errFormat
+			}
+
+			rest = strings.TrimSpace(v[i+1:])
+			if rest != "" && !strings.HasPrefix(rest, ",") {
+				return nil, errFormat
+			}
+			rest = strings.TrimPrefix(rest, ",")
+
+			res = append(res, [2]string{key, value.String()})
+			lastQuoted = true
+			continue
+		}
+
+		if n := strings.Index(rest, ","); n >= 0 {
+			part, rest = rest[:n], rest[n+1:]
+		} else {
+			part, rest = rest, ""
+		}
+
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		switch {
+		case len(kv) == 2 && kv[0] != "":
+			res = append(res, [2]string{kv[0], kv[1]})
+			lastQuoted = false
+		case len(kv) == 1 && len(res) > 0 && !lastQuoted:
+			// An unquoted comma inside the value.
+			res[len(res)-1][1] += "," + part
+		default:
+			return nil, errFormat
+		}
+	}
+
+	if len(res) == 0 {
+		return nil, errFormat
+	}
+
+	return res, nil
+}
+
+// unknownValue The value of a list element that is not known during the plan.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// ReferencesCustomizeDiff Checks that the objects referenced by the fields declared in the 'MetaReferences'
+// field exist on the router. The check is only performed if the 'validate_references' option of the provider
+// is enabled. Values that are unknown during the plan (objects created in the same plan) and the literals
+// allowed for the reference are skipped.
+func ReferencesCustomizeDiff(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceDiff, m interface{}) error {
+	c, ok := m.(Client)
+	if !ok || !c.GetOptions().ValidateReferences {
+		return nil
+	}
+
+	rs, ok := s[MetaReferences]
+	if !ok {
+		return nil
+	}
+	refs := loadReferences(rs.Default.(string))
+
+	var fields []string
+	for field := range refs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	// path:field -> values
+	existing := make(map[string]map[string]struct{})
+	var missing []string
+
+	for _, field := range fields {
+		if !d.HasChange(field) || !d.NewValueKnown(field) {
+			continue
+		}
+
+		var values []string
+		switch v := d.Get(field).(type) {
+		case string:
+			values = []string{v}
+		case []interface{}:
+			for _, e := range v {
+				values = append(values, e.(string))
+			}
+		case *schema.Set:
+			for _, e := range v.List() {
+				values = append(values, e.(string))
+			}
+		}
+
+		ref := refs[field]
+		key := ref.Path + ":" + ref.Field
+
+		for _, value := range values {
+			// in-interface=!ether1
+			value = strings.TrimPrefix(value, "!")
+			if value == "" || value == unknownValue || ref.IsLiteral(value) {
+				continue
+			}
+
+			if _, ok := existing[key]; !ok {
+				// Only the referenced field is read, the menu can contain many items.
+				res, err := ReadItemsField(SnakeToKebab(ref.Field), ref.Path, c)
+				if err != nil {
+					ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+					return err
+				}
+
+				existing[key] = make(map[string]struct{})
+				for _, v := range res {
+					existing[key][v] = struct{}{}
+				}
+			}
+
+			if _, ok := existing[key][value]; !ok {
+				missing = append(missing, fmt.Sprintf("%v: '%v' was not found in '%v'", field, value, ref.Path))
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("the referenced objects do not exist on the router:\n%v", strings.Join(missing, "\n"))
+	}
+
+	return nil
+}
+
+// NestedBlocksCustomizeDiff Checking the values of the nested blocks with the joined encoding.
+func NestedBlocksCustomizeDiff(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceDiff, m interface{}) error {
+	for name, enc := range getNestedBlocks(s) {
+		if enc.Type != NestedJoined || !d.HasChange(name) {
+			continue
+		}
+
+		var blocks []interface{}
+		switch v := d.Get(name).(type) {
+		case []interface{}:
+			blocks = v
+		case *schema.Set:
+			blocks = v.List()
+		}
+
+		if err := checkNestedJoinedValues(name, blocks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SecretsCustomizeDiff The hashes of the secret fields declared in the 'MetaSecrets' field are recalculated
+// when any of the secrets is changed.
+func SecretsCustomizeDiff(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceDiff, m interface{}) error {
+	if _, ok := s[KeySecretHashes]; !ok {
+		return nil
+	}
+
+	for name := range getSecrets(s) {
+		if d.HasChange(name) {
+			return d.SetNewComputed(KeySecretHashes)
+		}
+	}
+
+	return nil
+}
+
 // SystemResourceRead The difference from the normal reading is in the method of generation of Id.
 func SystemResourceRead(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	metadata := GetMetadata(s)
 
-	res := MikrotikItem{}
-	err := m.(Client).SendRequest(crudRead, &URL{Path: metadata.Path}, nil, &res)
+	res, err := ReadSystemItem(metadata.Path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -197,16 +737,87 @@ func SystemResourceCreateUpdate(ctx context.Context, s map[string]*schema.Schema
 		return diag.FromErr(err)
 	}
 
+	if err = updateSecretHashes(s, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return SystemResourceRead(ctx, s, d, m)
 }
 
 // SystemResourceDelete Delete function will remove the object from the Terraform state
 // No delete functionality provided by API for System Resources.
-func SystemResourceDelete(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// If the resource supports the 'reset_on_destroy' field and it is set, the settings are reset to the factory defaults.
+func SystemResourceDelete(ctx context.Context, s map[string]*schema.Schema, defaults MikrotikItem, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+
+	if _, ok := s[KeyResetOnDestroy]; ok && d.Get(KeyResetOnDestroy).(bool) {
+		if diags := SystemResourceReset(ctx, s, defaults, d, m); diags.HasError() {
+			return diags
+		}
+
+		d.SetId("")
+		return nil
+	}
+
 	d.SetId("")
 	return DeleteSystemObject
 }
 
+// SystemResourceReset Sending the factory defaults for all writable fields of the system resource.
+// The values are taken from the defaults table (in the Mikrotik notation!) or from the schema defaults,
+// fields without a known default are left unchanged.
+func SystemResourceReset(ctx context.Context, s map[string]*schema.Schema, defaults MikrotikItem, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+
+	metadata := GetMetadata(s)
+	item := resetItem(s, defaults)
+
+	var resUrl string
+	if m.(Client).GetTransport() == TransportREST {
+		// https://router/rest/ip/dns/set
+		resUrl = "/set"
+	}
+
+	// Used POST request!
+	if err := m.(Client).SendRequest(crudPost, &URL{Path: metadata.Path + resUrl}, item, nil); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resetItem Collecting the factory defaults for all writable fields of the resource.
+// The values are taken from the defaults table (in the Mikrotik notation!) or from the schema defaults,
+// fields without a known default are not included.
+func resetItem(s map[string]*schema.Schema, defaults MikrotikItem) MikrotikItem {
+	item := MikrotikItem{}
+
+	for terraformSnakeName, terraformMetadata := range s {
+		if reMetadataFields.MatchString(terraformSnakeName) || terraformSnakeName == KeyResetOnDestroy ||
+			terraformMetadata.Computed && !terraformMetadata.Optional {
+			continue
+		}
+
+		mikrotikKebabName := SnakeToKebab(terraformSnakeName)
+		if value, ok := defaults[mikrotikKebabName]; ok {
+			item[mikrotikKebabName] = value
+			continue
+		}
+
+		switch value := terraformMetadata.Default.(type) {
+		case string:
+			item[mikrotikKebabName] = value
+		case int:
+			item[mikrotikKebabName] = strconv.Itoa(value)
+		case bool:
+			item[mikrotikKebabName] = BoolToMikrotikJSON(value)
+		}
+	}
+
+	return item
+}
+
 func DefaultCreate(s map[string]*schema.Schema) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		return ResourceCreate(ctx, s, d, m)
@@ -231,6 +842,18 @@ func DefaultDelete(s map[string]*schema.Schema) schema.DeleteContextFunc {
 	}
 }
 
+func DefaultCustomizeDiff(s map[string]*schema.Schema) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if err := SecretsCustomizeDiff(ctx, s, d, m); err != nil {
+			return err
+		}
+		if err := NestedBlocksCustomizeDiff(ctx, s, d, m); err != nil {
+			return err
+		}
+		return ReferencesCustomizeDiff(ctx, s, d, m)
+	}
+}
+
 func DefaultValidateCreate(s map[string]*schema.Schema, f DataValidateFunc) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		if f != nil {
@@ -273,6 +896,14 @@ func DefaultSystemUpdate(s map[string]*schema.Schema) schema.UpdateContextFunc {
 
 func DefaultSystemDelete(s map[string]*schema.Schema) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		return SystemResourceDelete(ctx, s, d, m)
+		return SystemResourceDelete(ctx, s, nil, d, m)
+	}
+}
+
+// DefaultSystemResetDelete The same as DefaultSystemDelete, the defaults table (in the Mikrotik notation!)
+// is used when the settings are reset on destroy.
+func DefaultSystemResetDelete(s map[string]*schema.Schema, defaults MikrotikItem) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return SystemResourceDelete(ctx, s, defaults, d, m)
 	}
 }
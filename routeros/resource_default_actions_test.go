@@ -6,6 +6,7 @@ import (
 	"log"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -46,7 +47,7 @@ func Test_defaultResourceCreateContext(t *testing.T) {
 
 	err := json.Unmarshal([]byte(s), &m2)
 	if err != nil {
-		t.Errorf(err.Error())
+		t.Error(err)
 	}
 
 	for k, v := range m2 {
@@ -73,3 +74,241 @@ func Test_defaultResourceCreateContext(t *testing.T) {
 		}
 	}
 }
+
+func Test_parseImportQuery(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    [][2]string
+		wantErr bool
+	}{
+		{"name=ether1", [][2]string{{"name", "ether1"}}, false},
+		{"chain=forward, src_address=10.0.0.1", [][2]string{{"chain", "forward"}, {"src_address", "10.0.0.1"}}, false},
+		{"comment=Office, VPN", [][2]string{{"comment", "Office, VPN"}}, false},
+		{`comment="Office, VPN",chain=input`, [][2]string{{"comment", "Office, VPN"}, {"chain", "input"}}, false},
+		{`comment="say \"hi\", \\ok"`, [][2]string{{"comment", `say "hi", \ok`}}, false},
+		{"comment=", [][2]string{{"comment", ""}}, false},
+		{`comment="unclosed`, nil, true},
+		{`comment="a"b`, nil, true},
+		{"=value", nil, true},
+		{"value,name=ether1", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := parseImportQuery(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseImportQuery() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// testMenuClient Serves a single menu from memory and records the requested URLs.
+// The query filters only apply to the items that have the filtered field.
+type testMenuClient struct {
+	transport TransportType
+	options   ClientOptions
+	items     []MikrotikItem
+	urls      []string
+	logins    []string // The users that logged in.
+	loginFail bool
+}
+
+func (c *testMenuClient) GetTransport() TransportType { return c.transport }
+func (c *testMenuClient) GetOptions() *ClientOptions  { return &c.options }
+func (c *testMenuClient) CheckLogin(username, _ string) (bool, error) {
+	c.logins = append(c.logins, username)
+	return !c.loginFail, nil
+}
+
+func (c *testMenuClient) SendRequest(method crudMethod, url *URL, _ MikrotikItem, result interface{}) error {
+	c.urls = append(c.urls, url.GetRestURL())
+
+	if method != crudRead {
+		return nil
+	}
+
+	res := result.(*[]MikrotikItem)
+	for _, item := range c.items {
+		match := true
+		for _, q := range url.Query {
+			// ?.id=*1, ?=list=lan, =.proplist=.id
+			f := strings.SplitN(strings.TrimPrefix(strings.TrimPrefix(q, "?"), "="), "=", 2)
+			if v, ok := item[f[0]]; ok && f[0] != ".proplist" && v != f[1] {
+				match = false
+			}
+		}
+		if match {
+			*res = append(*res, item)
+		}
+	}
+	return nil
+}
+
+func Test_readItemsCommentTag(t *testing.T) {
+	tag, _ := newCommentTag()
+
+	for _, transport := range []TransportType{TransportREST, TransportAPI} {
+		c := &testMenuClient{
+			transport: transport,
+			items: []MikrotikItem{
+				{".id": "*1", "comment": "uplink"},
+				{".id": "*2", "comment": tag + " uplink"},
+			},
+		}
+
+		res, err := ReadItems(&ItemId{CommentTag, tag}, "/ip/route", c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(*res) != 1 || (*res)[0][".id"] != "*2" {
+			t.Fatalf("%v: expected the item '*2', got: %v", transport, *res)
+		}
+
+		var expected = []string{"/ip/route?.proplist=.id,comment&dynamic=false", "/ip/route?.id=*2"}
+		if transport == TransportAPI {
+			expected[0] = "/ip/route?=.proplist=.id,comment&?dynamic=false"
+		}
+		if !reflect.DeepEqual(c.urls, expected) {
+			t.Fatalf("%v: expected:%v\nactual:%v", transport, expected, c.urls)
+		}
+
+		// The tag was not found.
+		other, _ := newCommentTag()
+		if res, err = ReadItems(&ItemId{CommentTag, other}, "/ip/route", c); err != nil || len(*res) != 0 {
+			t.Fatalf("%v: expected no items, got: %v, %v", transport, *res, err)
+		}
+	}
+}
+
+func Test_resourceIdType(t *testing.T) {
+	tag, _ := newCommentTag()
+
+	tests := []struct {
+		commentTagId bool
+		id           string
+		want         IdType
+	}{
+		{false, "", Id},
+		{false, "*1A", Id},
+		{false, tag, CommentTag},
+		{true, "", CommentTag},
+		{true, "*1A", CommentTag},
+	}
+	for _, tt := range tests {
+		d := ResourceIPRoute().TestResourceData()
+		d.SetId(tt.id)
+		c := &testMenuClient{options: ClientOptions{CommentTagId: tt.commentTagId}}
+
+		if got := resourceIdType(CommentTag, d, c); got != tt.want {
+			t.Errorf("resourceIdType(%v, %q) = %v, want %v", tt.commentTagId, tt.id, got, tt.want)
+		}
+		if got := resourceIdType(Name, d, c); got != Name {
+			t.Errorf("resourceIdType(%v, %q) = %v, want %v", tt.commentTagId, tt.id, got, Name)
+		}
+	}
+}
+
+func Test_nestedItemsOwned(t *testing.T) {
+	r := ResourceInterfaceList()
+
+	d := r.TestResourceData()
+	d.SetId("lan")
+	d.Set("members", []interface{}{
+		map[string]interface{}{"id": "*2", "interface": "ether2"},
+		map[string]interface{}{"id": "*9", "interface": "ether9"},
+	})
+
+	c := &testMenuClient{
+		transport: TransportREST,
+		items: []MikrotikItem{
+			// The member of the 'routeros_interface_list_member' resource.
+			{".id": "*1", "list": "lan", "interface": "ether1", "dynamic": "false"},
+			{".id": "*2", "list": "lan", "interface": "ether2", "dynamic": "false"},
+			{".id": "*3", "list": "lan", "interface": "bridge", "dynamic": "true"},
+			{".id": "*4", "list": "wan", "interface": "ether5", "dynamic": "false"},
+		},
+	}
+
+	if diags := nestedItemsRead(r.Schema, d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+	expected := []interface{}{
+		map[string]interface{}{"id": "*2", "interface": "ether2", "comment": "", "disabled": false},
+	}
+	if actual := d.Get("members"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, actual)
+	}
+
+	// Only the recorded member is deleted.
+	d = r.Data(d.State())
+	c.urls = nil
+	if err := nestedItemsDelete("", r.Schema, d, c); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"/interface/list/member?list=lan", "/interface/list/member/*2"}; !reflect.DeepEqual(c.urls, expected) {
+		t.Fatalf("bad: expected:%v\nactual:%v", expected, c.urls)
+	}
+}
+
+func Test_readItemsField(t *testing.T) {
+	for _, transport := range []TransportType{TransportREST, TransportAPI} {
+		c := &testMenuClient{
+			transport: transport,
+			items: []MikrotikItem{
+				{".id": "*1", "name": "ether1"},
+				{".id": "*2", "name": "bridge"},
+			},
+		}
+
+		res, err := ReadItemsField("name", "/interface", c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"ether1", "bridge"}; !reflect.DeepEqual(res, expected) {
+			t.Fatalf("%v: expected:%v\nactual:%v", transport, expected, res)
+		}
+
+		expected := []string{"/interface?.proplist=name"}
+		if transport == TransportAPI {
+			expected[0] = "/interface?=.proplist=name"
+		}
+		if !reflect.DeepEqual(c.urls, expected) {
+			t.Fatalf("%v: expected:%v\nactual:%v", transport, expected, c.urls)
+		}
+	}
+}
+
+func Test_readItemsOrderCached(t *testing.T) {
+	c := &testMenuClient{
+		transport: TransportREST,
+		options:   ClientOptions{itemsOrder: &itemsOrderCache{}},
+		items:     []MikrotikItem{{".id": "*1"}, {".id": "*2"}},
+	}
+
+	for i := 0; i < 3; i++ {
+		order, err := ReadItemsOrderCached("/ip/firewall/filter", c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"*1", "*2"}; !reflect.DeepEqual(order, expected) {
+			t.Fatalf("expected:%v\nactual:%v", expected, order)
+		}
+	}
+	if len(c.urls) != 1 {
+		t.Fatalf("the order must be read once, requests: %v", c.urls)
+	}
+
+	// The order is read again after the menu has been changed.
+	if err := MoveItem(&ItemId{Id, "*2"}, "*1", "/ip/firewall/filter", c); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadItemsOrderCached("/ip/firewall/filter", c); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.urls) != 3 {
+		t.Fatalf("the order must be read again after the move, requests: %v", c.urls)
+	}
+}
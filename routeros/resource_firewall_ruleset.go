@@ -0,0 +1,647 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	KeyChains         = "chains"
+	KeyRule           = "rule"
+	KeyUnmanagedRules = "unmanaged_rules"
+
+	unmanagedRulesDelete = "delete"
+	unmanagedRulesReport = "report"
+)
+
+// ResourceIPFirewallFilterRuleset https://wiki.mikrotik.com/wiki/Manual:IP/Firewall/Filter
+func ResourceIPFirewallFilterRuleset() *schema.Resource {
+	return ResourceFirewallRuleset(ResourceIPFirewallFilter())
+}
+
+// ResourceIPFirewallNatRuleset https://wiki.mikrotik.com/wiki/Manual:IP/Firewall/NAT
+func ResourceIPFirewallNatRuleset() *schema.Resource {
+	return ResourceFirewallRuleset(ResourceIPFirewallNat())
+}
+
+// ResourceIPFirewallMangleRuleset https://wiki.mikrotik.com/wiki/Manual:IP/Firewall/Mangle
+func ResourceIPFirewallMangleRuleset() *schema.Resource {
+	return ResourceFirewallRuleset(ResourceIPFirewallMangle())
+}
+
+// ResourceIPv6FirewallFilterRuleset https://help.mikrotik.com/docs/display/ROS/Filter
+func ResourceIPv6FirewallFilterRuleset() *schema.Resource {
+	return ResourceFirewallRuleset(ResourceIPv6FirewallFilter())
+}
+
+// ResourceFirewallRuleset An authoritative ordered set of rules for one or more chains of the firewall table.
+// The schema of the rule blocks is built from the schema of the single rule resource.
+func ResourceFirewallRuleset(rule *schema.Resource) *schema.Resource {
+	ruleSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Rule ID.",
+		},
+	}
+
+	for name, field := range rule.Schema {
+		// Skip the metadata fields, the position of the rule is defined by the order of the blocks.
+		if reMetadataFields.MatchString(name) || name == KeyPlaceBefore {
+			continue
+		}
+		// Skip all read-only properties (counters, flags).
+		if field.Computed && !field.Optional {
+			continue
+		}
+
+		f := *field
+		f.ForceNew = false
+		ruleSchema[name] = &f
+	}
+
+	// The values of the rule blocks are compared in the canonical form, as in the single rule resource.
+	normalizers := getNormalizers(rule.Schema)
+	for name, n := range normalizers {
+		if f, ok := ruleSchema[name]; ok {
+			f.DiffSuppressFunc = normalizedEqual(n, f.DiffSuppressFunc)
+		}
+	}
+
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath(rule.Schema[MetaResourcePath].Default.(string)),
+		MetaId:           PropId(Id),
+
+		KeyChains: {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The chains managed by the ruleset. By default, these are the chains used in the rules. " +
+				"A chain listed here without any rules will be cleared.",
+		},
+		KeyRule: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: ruleSchema,
+			},
+			Description: "An ordered list of firewall rules. The router is reconciled to exactly this sequence " +
+				"of rules for each managed chain.",
+		},
+		KeyUnmanagedRules: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  unmanagedRulesDelete,
+			Description: "What to do with the rules of the managed chains that are not described in the ruleset: " +
+				"'delete' - the rules are shown as drift and removed on the next apply, 'report' - the rules are " +
+				"left untouched and a warning is issued.",
+			ValidateFunc: validation.StringInSlice([]string{unmanagedRulesDelete, unmanagedRulesReport}, false),
+		},
+	}
+
+	return &schema.Resource{
+		Description: "An authoritative ordered list of rules for one or more chains of the " +
+			rule.Schema[MetaResourcePath].Default.(string) + " table.",
+
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return firewallRulesetReconcile(ctx, resSchema, normalizers, d, m)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return firewallRulesetRead(ctx, resSchema, d, m)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return firewallRulesetReconcile(ctx, resSchema, normalizers, d, m)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return firewallRulesetDelete(ctx, resSchema, d, m)
+		},
+
+		// The import ID is a comma-separated list of chains: input,forward
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
+
+// firewallRulesetChains Returns the sorted list of the managed chains. The chains of the old state are included
+// so that the rules of the removed chains are deleted.
+func firewallRulesetChains(d *schema.ResourceData, withOld bool) []string {
+	var chains = make(map[string]struct{})
+
+	for _, chain := range d.Get(KeyChains).(*schema.Set).List() {
+		chains[chain.(string)] = struct{}{}
+	}
+
+	for _, rule := range d.Get(KeyRule).([]interface{}) {
+		chains[rule.(map[string]interface{})["chain"].(string)] = struct{}{}
+	}
+
+	if withOld {
+		old, _ := d.GetChange(KeyRule)
+		for _, rule := range old.([]interface{}) {
+			chains[rule.(map[string]interface{})["chain"].(string)] = struct{}{}
+		}
+	}
+
+	// Import: the resource ID contains the list of chains.
+	if len(chains) == 0 && d.Id() != "" {
+		for _, chain := range strings.Split(d.Id(), ",") {
+			chains[chain] = struct{}{}
+		}
+	}
+
+	var res []string
+	for chain := range chains {
+		res = append(res, chain)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// firewallRulesetStateIds Returns the IDs of the rules managed by the ruleset according to the state.
+func firewallRulesetStateIds(d *schema.ResourceData) map[string]struct{} {
+	var res = make(map[string]struct{})
+
+	old, _ := d.GetChange(KeyRule)
+	for _, rule := range old.([]interface{}) {
+		if id := rule.(map[string]interface{})["id"].(string); id != "" {
+			res[id] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// firewallRulesetItems Returns the static rules of the managed chains in the order of the firewall table.
+func firewallRulesetItems(path string, chains []string, c Client) ([]MikrotikItem, error) {
+	items, err := ReadItems(nil, path, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []MikrotikItem
+	for _, item := range *items {
+		if BoolFromMikrotikJSON(item[KeyDynamic]) {
+			continue
+		}
+
+		for _, chain := range chains {
+			if item["chain"] == chain {
+				res = append(res, item)
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// firewallRuleToBlock Unmarshal the Mikrotik rule into the rule block.
+func firewallRuleToBlock(item MikrotikItem, s map[string]*schema.Schema) map[string]interface{} {
+	var block = map[string]interface{}{"id": item.GetID(Id)}
+
+	for mikrotikKebabName, mikrotikValue := range item {
+		fieldSchema, ok := s[KebabToSnake(mikrotikKebabName)]
+		if !ok || mikrotikKebabName[0:1] == "." {
+			continue
+		}
+
+		if v, err := nestedValueFromMikrotik(fieldSchema, mikrotikValue); err == nil {
+			block[KebabToSnake(mikrotikKebabName)] = v
+		}
+	}
+
+	return block
+}
+
+// firewallRuleFromBlock Marshal the rule block into the Mikrotik rule.
+// Only the fields specified in the configuration or having a default value are used.
+func firewallRuleFromBlock(block map[string]interface{}, config cty.Value, s map[string]*schema.Schema) MikrotikItem {
+	var item = MikrotikItem{}
+
+	for name, fieldSchema := range s {
+		if name == "id" {
+			continue
+		}
+
+		if fieldSchema.Default == nil && (config.IsNull() || !config.IsKnown() || config.GetAttr(name).IsNull()) {
+			continue
+		}
+
+		if v := nestedValueToMikrotik(block[name]); v != "" {
+			item[SnakeToKebab(name)] = v
+		}
+	}
+
+	return item
+}
+
+// firewallRuleEqual Comparison of the existing Mikrotik rule with the desired one.
+// The values of the fields with declared normalizers are compared in the canonical form.
+func firewallRuleEqual(existing, desired MikrotikItem, s map[string]*schema.Schema, normalizers map[string]*Normalizer) bool {
+	for name, fieldSchema := range s {
+		if name == "id" {
+			continue
+		}
+
+		mikrotikKebabName := SnakeToKebab(name)
+		ev, dv := existing[mikrotikKebabName], desired[mikrotikKebabName]
+
+		if fieldSchema.Type == schema.TypeBool {
+			if ev != "" {
+				ev = BoolToMikrotikJSON(BoolFromMikrotikJSON(ev))
+			}
+			// An unspecified boolean field is equal to 'no'.
+			if _, ok := desired[mikrotikKebabName]; !ok && ev == "no" {
+				continue
+			}
+		}
+
+		// The value is calculated by the router.
+		if _, ok := desired[mikrotikKebabName]; !ok && fieldSchema.Computed {
+			continue
+		}
+
+		if n, ok := normalizers[name]; ok && ev != "" && dv != "" {
+			ev, dv = n.Normalize(ev), n.Normalize(dv)
+		}
+
+		if ev != dv {
+			return false
+		}
+	}
+
+	return true
+}
+
+// firewallRuleUpdate Building the update of the existing rule that is rewritten into the desired one.
+// The fields that are no longer used are cleared, boolean fields without a value are set to 'no'.
+func firewallRuleUpdate(existing, desired MikrotikItem, s map[string]*schema.Schema) MikrotikItem {
+	update := MikrotikItem{}
+	for k, v := range desired {
+		update[k] = v
+	}
+
+	for name, fieldSchema := range s {
+		k := SnakeToKebab(name)
+		if _, ok := update[k]; ok || existing[k] == "" || name == "id" || fieldSchema.Computed {
+			continue
+		}
+
+		if fieldSchema.Type == schema.TypeBool {
+			if BoolFromMikrotikJSON(existing[k]) {
+				update[k] = BoolToMikrotikJSON(false)
+			}
+			continue
+		}
+
+		update[k] = ""
+	}
+
+	return update
+}
+
+// firewallRulesetReuse Pairing of the changed desired rules with the removable rules of the same chain,
+// which are rewritten in place. A rule is only reused if it is already located between the unchanged rules
+// that precede and follow the desired rule, so the rewritten rule never acts at the wrong place in the chain.
+// Returns the map: desired rule index -> existing rule index.
+func firewallRulesetReuse(desired, existing []MikrotikItem, matched map[int]int, removable []int) map[int]int {
+	var res = make(map[int]int)
+
+	// The lowest position of the unchanged rules that follow each desired rule of the chain.
+	var nextPos = make([]int, len(desired))
+	var minPos = make(map[string]int)
+	for i := len(desired) - 1; i >= 0; i-- {
+		chain := desired[i]["chain"]
+		if _, ok := minPos[chain]; !ok {
+			minPos[chain] = len(existing)
+		}
+		nextPos[i] = minPos[chain]
+		if j, ok := matched[i]; ok && j < minPos[chain] {
+			minPos[chain] = j
+		}
+	}
+
+	// The highest position of the unchanged or reused rules that precede the desired rule of the chain.
+	var prevPos = make(map[string]int)
+	var used = make(map[int]struct{})
+
+	for i, rule := range desired {
+		chain := rule["chain"]
+		if _, ok := prevPos[chain]; !ok {
+			prevPos[chain] = -1
+		}
+
+		if j, ok := matched[i]; ok {
+			if j > prevPos[chain] {
+				prevPos[chain] = j
+			}
+			continue
+		}
+
+		for _, j := range removable {
+			if _, ok := used[j]; ok || existing[j]["chain"] != chain || j <= prevPos[chain] || j >= nextPos[i] {
+				continue
+			}
+			used[j] = struct{}{}
+			res[i] = j
+			prevPos[chain] = j
+			break
+		}
+	}
+
+	return res
+}
+
+// firewallRulesetRead Reading the rules of the managed chains.
+// Depending on the 'unmanaged_rules' value, the rules that are not present in the state are either included
+// in the state (and will be removed on the next apply) or reported.
+func firewallRulesetRead(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	metadata := GetMetadata(s)
+	ruleSchema := s[KeyRule].Elem.(*schema.Resource).Schema
+
+	chains := firewallRulesetChains(d, false)
+	items, err := firewallRulesetItems(metadata.Path, chains, m.(Client))
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+		return diag.FromErr(err)
+	}
+
+	stateIds := firewallRulesetStateIds(d)
+	report := d.Get(KeyUnmanagedRules).(string) == unmanagedRulesReport
+
+	// The resource has been imported: all rules of the chains are adopted.
+	if d.Get(KeyUnmanagedRules).(string) == "" {
+		if err = d.Set(KeyUnmanagedRules, unmanagedRulesDelete); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var rules []interface{}
+	var unmanaged []string
+
+	for _, item := range items {
+		if _, ok := stateIds[item.GetID(Id)]; !ok && report {
+			unmanaged = append(unmanaged, fmt.Sprintf("%v (chain=%v)", item.GetID(Id), item["chain"]))
+			continue
+		}
+		rules = append(rules, firewallRuleToBlock(item, ruleSchema))
+	}
+
+	if len(unmanaged) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unmanaged firewall rules found",
+			Detail: fmt.Sprintf("The following rules of the %v table are not managed by the ruleset: %v",
+				metadata.Path, strings.Join(unmanaged, ", ")),
+		})
+	}
+
+	d.SetId(strings.Join(chains, ","))
+
+	if err = d.Set(KeyRule, rules); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// firewallRulesetReconcile Reconciling the rules of the managed chains with the desired sequence
+// using the minimum number of add, set, move and remove operations.
+func firewallRulesetReconcile(ctx context.Context, s map[string]*schema.Schema, normalizers map[string]*Normalizer,
+	d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	metadata := GetMetadata(s)
+	ruleSchema := s[KeyRule].Elem.(*schema.Resource).Schema
+	c := m.(Client)
+
+	chains := firewallRulesetChains(d, true)
+	existing, err := firewallRulesetItems(metadata.Path, chains, c)
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+		return diag.FromErr(err)
+	}
+
+	// Desired rules.
+	var desired []MikrotikItem
+	var configs []cty.Value
+	if rawConfig := d.GetRawConfig().GetAttr(KeyRule); !rawConfig.IsNull() && rawConfig.IsKnown() {
+		configs = rawConfig.AsValueSlice()
+	}
+	for i, block := range d.Get(KeyRule).([]interface{}) {
+		config := cty.NullVal(cty.DynamicPseudoType)
+		if i < len(configs) {
+			config = configs[i]
+		}
+		desired = append(desired, firewallRuleFromBlock(block.(map[string]interface{}), config, ruleSchema))
+	}
+
+	stateIds := firewallRulesetStateIds(d)
+	deleteUnmanaged := d.Get(KeyUnmanagedRules).(string) == unmanagedRulesDelete
+
+	var desiredIds = make([]string, len(desired))
+	var matched = make(map[int]int)
+	var used = make(map[int]struct{})
+
+	// 1. Rules that already exist on the router.
+	for i, rule := range desired {
+		for j, item := range existing {
+			if _, ok := used[j]; ok || item["chain"] != rule["chain"] ||
+				!firewallRuleEqual(item, rule, ruleSchema, normalizers) {
+				continue
+			}
+			used[j] = struct{}{}
+			matched[i] = j
+			desiredIds[i] = item.GetID(Id)
+			break
+		}
+	}
+
+	// Rules that can be changed or removed.
+	var removable []int
+	for j, item := range existing {
+		if _, ok := used[j]; ok {
+			continue
+		}
+		if _, ok := stateIds[item.GetID(Id)]; ok || deleteUnmanaged {
+			removable = append(removable, j)
+		}
+	}
+
+	// 2. Reuse of the changed rules of the same chain that are already in the right place.
+	reused := firewallRulesetReuse(desired, existing, matched, removable)
+	for i, rule := range desired {
+		j, ok := reused[i]
+		if !ok {
+			continue
+		}
+		item := existing[j]
+
+		if _, err = UpdateItem(&ItemId{Id, item.GetID(Id)}, metadata.Path, firewallRuleUpdate(item, rule, ruleSchema), c); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(err)
+		}
+
+		desiredIds[i] = item.GetID(Id)
+		used[j] = struct{}{}
+	}
+
+	// 3. New rules are added disabled to the end of the table and are enabled after they have been moved
+	// to their place.
+	var enable []string
+	for i, rule := range desired {
+		if desiredIds[i] != "" {
+			continue
+		}
+
+		item := MikrotikItem{}
+		for k, v := range rule {
+			item[k] = v
+		}
+		if !BoolFromMikrotikJSON(rule[KeyDisabled]) {
+			item[KeyDisabled] = BoolToMikrotikJSON(true)
+		}
+
+		res, err := CreateItem(item, metadata.Path, c)
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+			return diag.FromErr(err)
+		}
+
+		if desiredIds[i] = res.GetID(Id); desiredIds[i] == "" {
+			return diag.Errorf("the rule ID was not found in the response")
+		}
+
+		if item[KeyDisabled] != rule[KeyDisabled] {
+			enable = append(enable, desiredIds[i])
+		}
+	}
+
+	// 4. Ordering of the rules within each chain.
+	order, err := ReadItemsOrder(metadata.Path, c)
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+		return diag.FromErr(err)
+	}
+
+	var positions = make(map[string]int)
+	for i, id := range order {
+		positions[id] = i
+	}
+
+	for _, chain := range chains {
+		var ids []string
+		for i, rule := range desired {
+			if rule["chain"] == chain {
+				ids = append(ids, desiredIds[i])
+			}
+		}
+
+		for _, mv := range firewallRulesetMoves(ids, positions) {
+			if err = MoveItem(&ItemId{Id, mv[0]}, mv[1], metadata.Path, c); err != nil {
+				ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	// 5. The new rules are in place and can be enabled.
+	for _, id := range enable {
+		if _, err = UpdateItem(&ItemId{Id, id}, metadata.Path, MikrotikItem{KeyDisabled: BoolToMikrotikJSON(false)}, c); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(err)
+		}
+	}
+
+	// 6. Removal of the rules that are no longer needed, after the rules replacing them are in place.
+	for _, j := range removable {
+		if _, ok := used[j]; ok {
+			continue
+		}
+		if err = DeleteItem(&ItemId{Id, existing[j].GetID(Id)}, metadata.Path, c); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(strings.Join(firewallRulesetChains(d, false), ","))
+
+	return firewallRulesetRead(ctx, s, d, m)
+}
+
+// firewallRulesetMoves Returns the list of moves {id, destination} required to arrange the rules in the given order.
+// The rules that form the longest sequence in the correct order stay in place, the rest are moved in reverse
+// order before the next rule, which is already in its final position.
+func firewallRulesetMoves(ids []string, positions map[string]int) (res [][2]string) {
+	// Longest increasing subsequence of the current positions.
+	var tails, prev = []int{}, make([]int, len(ids))
+	for i, id := range ids {
+		n := sort.Search(len(tails), func(k int) bool { return positions[ids[tails[k]]] >= positions[id] })
+		if n > 0 {
+			prev[i] = tails[n-1]
+		} else {
+			prev[i] = -1
+		}
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+
+	var stay = make(map[int]struct{})
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			stay[i] = struct{}{}
+		}
+	}
+
+	var destination string
+	for i := len(ids) - 1; i >= 0; i-- {
+		if _, ok := stay[i]; !ok {
+			res = append(res, [2]string{ids[i], destination})
+		}
+		destination = ids[i]
+	}
+
+	return
+}
+
+// firewallRulesetDelete Deleting the rules managed by the ruleset.
+func firewallRulesetDelete(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	metadata := GetMetadata(s)
+	stateIds := firewallRulesetStateIds(d)
+
+	items, err := firewallRulesetItems(metadata.Path, firewallRulesetChains(d, false), m.(Client))
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+		return diag.FromErr(err)
+	}
+
+	// Rules that have already been deleted outside of Terraform are skipped.
+	for _, item := range items {
+		if _, ok := stateIds[item.GetID(Id)]; !ok {
+			continue
+		}
+
+		if err = DeleteItem(&ItemId{Id, item.GetID(Id)}, metadata.Path, m.(Client)); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
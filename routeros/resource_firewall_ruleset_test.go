@@ -0,0 +1,243 @@
+package routeros
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIPFirewallFilterRulesetAddress = "routeros_ip_firewall_filter_ruleset.test"
+
+func TestAccIPFirewallFilterRulesetTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIPFirewallFilterRulesetConfig(0),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckIPFirewallFilterRulesetExists(testIPFirewallFilterRulesetAddress),
+							resource.TestCheckResourceAttr(testIPFirewallFilterRulesetAddress, "rule.#", "2"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterRulesetAddress, "rule.0.comment", "rule_1"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterRulesetAddress, "rule.1.comment", "rule_2"),
+						),
+					},
+					{
+						Config: testAccIPFirewallFilterRulesetConfig(1),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIPFirewallFilterRulesetAddress, "rule.#", "3"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterRulesetAddress, "rule.0.comment", "rule_3"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterRulesetAddress, "rule.1.comment", "rule_2"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterRulesetAddress, "rule.2.comment", "rule_1"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckIPFirewallFilterRulesetExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccIPFirewallFilterRulesetConfig(n int) string {
+	tests := []string{`
+resource "routeros_ip_firewall_filter_ruleset" "test" {
+	rule {
+		action      = "accept"
+		chain       = "tf-test"
+		src_address = "10.0.0.1"
+		comment     = "rule_1"
+	}
+
+	rule {
+		action      = "drop"
+		chain       = "tf-test"
+		src_address = "10.0.0.2"
+		comment     = "rule_2"
+	}
+}`, `
+resource "routeros_ip_firewall_filter_ruleset" "test" {
+	rule {
+		action      = "reject"
+		chain       = "tf-test"
+		src_address = "10.0.0.3"
+		comment     = "rule_3"
+	}
+
+	rule {
+		action      = "drop"
+		chain       = "tf-test"
+		src_address = "10.0.0.2"
+		comment     = "rule_2"
+	}
+
+	rule {
+		action      = "accept"
+		chain       = "tf-test"
+		src_address = "10.0.0.1"
+		comment     = "rule_1"
+	}
+}`,
+	}
+	return providerConfig + tests[n]
+}
+
+func Test_firewallRulesetMoves(t *testing.T) {
+	positions := map[string]int{"*1": 0, "*2": 1, "*3": 2, "*4": 3, "*5": 4}
+
+	tests := []struct {
+		name string
+		ids  []string
+		want [][2]string
+	}{
+		{"In order", []string{"*1", "*2", "*3"}, nil},
+		{"Last to first", []string{"*3", "*1", "*2"}, [][2]string{{"*3", "*1"}}},
+		{"First to last", []string{"*2", "*3", "*1"}, [][2]string{{"*1", ""}}},
+		{"Reverse", []string{"*3", "*2", "*1"}, [][2]string{{"*2", "*1"}, {"*3", "*2"}}},
+		{"Swap in the middle", []string{"*1", "*4", "*3", "*5"}, [][2]string{{"*4", "*3"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := firewallRulesetMoves(tt.ids, positions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("firewallRulesetMoves() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_firewallRulesetReuse(t *testing.T) {
+	// input: accept(*1) drop(*2) accept(*3) drop(*4)
+	existing := []MikrotikItem{
+		{".id": "*1", "chain": "input", "action": "accept"},
+		{".id": "*2", "chain": "input", "action": "drop"},
+		{".id": "*3", "chain": "input", "action": "accept"},
+		{".id": "*4", "chain": "input", "action": "drop"},
+	}
+
+	tests := []struct {
+		name      string
+		desired   []MikrotikItem
+		matched   map[int]int
+		removable []int
+		want      map[int]int
+	}{
+		{
+			"Changed in place",
+			[]MikrotikItem{{"chain": "input"}, {"chain": "input"}, {"chain": "input"}},
+			map[int]int{0: 0, 2: 2},
+			[]int{1, 3},
+			map[int]int{1: 1},
+		},
+		{
+			"Not between the unchanged rules",
+			[]MikrotikItem{{"chain": "input"}, {"chain": "input"}, {"chain": "input"}},
+			map[int]int{0: 0, 1: 2},
+			[]int{1, 3},
+			map[int]int{2: 3},
+		},
+		{
+			"Moved before the unchanged rule",
+			[]MikrotikItem{{"chain": "input"}, {"chain": "input"}},
+			map[int]int{1: 0},
+			[]int{1, 2, 3},
+			map[int]int{},
+		},
+		{
+			"Other chain",
+			[]MikrotikItem{{"chain": "forward"}},
+			map[int]int{},
+			[]int{0, 1, 2, 3},
+			map[int]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := firewallRulesetReuse(tt.desired, existing, tt.matched, tt.removable); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("firewallRulesetReuse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_firewallRuleEqual(t *testing.T) {
+	r := ResourceIPFirewallFilter()
+	normalizers := getNormalizers(r.Schema)
+	s := map[string]*schema.Schema{
+		"chain":            r.Schema["chain"],
+		"action":           r.Schema["action"],
+		"src_address":      r.Schema["src_address"],
+		"connection_state": r.Schema["connection_state"],
+	}
+
+	existing := MikrotikItem{"chain": "input", "action": "accept", "src-address": "10.0.0.1",
+		"connection-state": "established,related"}
+	desired := MikrotikItem{"chain": "input", "action": "accept", "src-address": "10.0.0.1/32",
+		"connection-state": "related,established"}
+
+	if !firewallRuleEqual(existing, desired, s, normalizers) {
+		t.Error("the rules must be equal in the canonical form")
+	}
+
+	desired["action"] = "drop"
+	if firewallRuleEqual(existing, desired, s, normalizers) {
+		t.Error("the rules with different actions must not be equal")
+	}
+}
+
+func Test_firewallRuleUpdate(t *testing.T) {
+	r := ResourceIPFirewallFilter()
+	normalizers := getNormalizers(r.Schema)
+	s := map[string]*schema.Schema{
+		"chain":       r.Schema["chain"],
+		"action":      r.Schema["action"],
+		"comment":     r.Schema["comment"],
+		"fragment":    r.Schema["fragment"],
+		"hw_offload":  r.Schema["hw_offload"],
+		"src_address": r.Schema["src_address"],
+	}
+
+	// The rule had 'fragment = true' and a source address, both fields were removed from the configuration.
+	existing := MikrotikItem{".id": "*1", "chain": "input", "action": "accept", "fragment": "true",
+		"hw-offload": "false", "src-address": "10.0.0.1"}
+	desired := MikrotikItem{"chain": "input", "action": "drop"}
+
+	update := firewallRuleUpdate(existing, desired, s)
+	expected := MikrotikItem{"chain": "input", "action": "drop", "fragment": "no", "src-address": ""}
+	if !reflect.DeepEqual(update, expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, update)
+	}
+
+	// The router applies the update, the next reconcile does not change the rule.
+	for k, v := range update {
+		if v == "" {
+			delete(existing, k)
+			continue
+		}
+		existing[k] = v
+	}
+	if !firewallRuleEqual(existing, desired, s, normalizers) {
+		t.Errorf("the updated rule must be equal to the desired one: %#v", existing)
+	}
+}
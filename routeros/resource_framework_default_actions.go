@@ -0,0 +1,251 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// FrameworkResource The framework resource with the default CRUD driven by the metadata attributes of the schema
+// (MetaResourcePath, MetaId), the equivalent of DefaultCreate, DefaultRead, DefaultUpdate and DefaultDelete.
+// The schema must contain the 'id' attribute (FwPropIdRo).
+type FrameworkResource struct {
+	name   string // The resource type name without the provider prefix: ip_pool, interface_vlan, ...
+	schema fwschema.Schema
+	client Client
+}
+
+var _ resource.ResourceWithConfigure = &FrameworkResource{}
+var _ resource.ResourceWithImportState = &FrameworkResource{}
+
+// NewFrameworkResource Returns the constructor of the framework resource for the 'frameworkResources' list.
+func NewFrameworkResource(name string, s fwschema.Schema) func() resource.Resource {
+	return func() resource.Resource {
+		return &FrameworkResource{name: name, schema: s}
+	}
+}
+
+func (r *FrameworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name
+}
+
+func (r *FrameworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema
+}
+
+func (r *FrameworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// The provider is not configured yet.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected Client, got: %T", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// state Converting the item to the state and handling the unknown fields according to the provider option.
+func (r *FrameworkResource) state(ctx context.Context, item MikrotikItem, prior tftypes.Value, id string) (tftypes.Value, fwdiag.Diagnostics) {
+	value, diags := MikrotikResourceDataToFramework(ctx, item, r.schema, prior, id)
+
+	var sdkDiags diag.Diagnostics
+	for _, d := range diags {
+		if name, ok := unknownFieldFwName(d); ok {
+			sdkDiags = append(sdkDiags, unknownFieldDiagnostic(name, d.Detail()))
+			continue
+		}
+		severity := diag.Warning
+		if d.Severity() == fwdiag.SeverityError {
+			severity = diag.Error
+		}
+		sdkDiags = append(sdkDiags, diag.Diagnostic{Severity: severity, Summary: d.Summary(), Detail: d.Detail()})
+	}
+
+	var res fwdiag.Diagnostics
+	for _, d := range r.client.GetOptions().filterUnknownFields("routeros_"+r.name, sdkDiags) {
+		if d.Severity == diag.Error {
+			res.AddError(d.Summary, d.Detail)
+		} else {
+			res.AddWarning(d.Summary, d.Detail)
+		}
+	}
+
+	return value, res
+}
+
+// lookupId Returns the internal Mikrotik id of the resource.
+func (r *FrameworkResource) lookupId(ctx context.Context, metadata *MikrotikItemMetadata, id string) (string, error) {
+	res, err := ReadItems(&ItemId{metadata.IdType, id}, metadata.Path, r.client)
+	if err != nil {
+		return "", err
+	}
+
+	if len(*res) == 0 {
+		return "", errorNoLongerExists
+	}
+
+	return (*res)[0].GetID(Id), nil
+}
+
+// Create Creation of a resource in accordance with the framework schema.
+func (r *FrameworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	item, metadata, err := FrameworkResourceDataToMikrotik(ctx, r.schema, req.Plan.Raw, tftypes.NewValue(req.Plan.Raw.Type(), nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Plan conversion", err.Error())
+		return
+	}
+
+	res, err := CreateItem(item, metadata.Path, r.client)
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+		resp.Diagnostics.AddError("Create error", err.Error())
+		return
+	}
+
+	if res.GetID(Id) == "" {
+		resp.Diagnostics.AddError("The resource ID was not found in the response", "")
+		return
+	}
+
+	var id string
+	switch metadata.IdType {
+	case Id:
+		id = res.GetID(Id)
+	case Name:
+		id = item.GetID(Name)
+	default:
+		resp.Diagnostics.AddError("Create error", fmt.Sprintf("the ID type is not supported: %v", metadata.IdType))
+		return
+	}
+
+	// We ask for information again in the case of API.
+	if r.client.GetTransport() == TransportAPI {
+		items, err := ReadItems(&ItemId{Id, res.GetID(Id)}, metadata.Path, r.client)
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+			resp.Diagnostics.AddError("Create error", err.Error())
+			return
+		}
+
+		if len(*items) == 0 {
+			resp.Diagnostics.AddError(fmt.Sprintf("Mikrotik resource path='%v' id='%v' not found",
+				metadata.Path, res.GetID(Id)), "")
+			return
+		}
+
+		res = (*items)[0]
+	}
+
+	value, diags := r.state(ctx, res, req.Plan.Raw, id)
+	resp.Diagnostics.Append(diags...)
+	resp.State.Raw = value
+}
+
+// Read Reading some information about one specific resource.
+func (r *FrameworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	metadata := FwGetMetadata(ctx, r.schema)
+
+	var id string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := ReadItems(&ItemId{metadata.IdType, id}, metadata.Path, r.client)
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+		resp.Diagnostics.AddError("Read error", err.Error())
+		return
+	}
+
+	// Resource not found.
+	if len(*res) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	value, diags := r.state(ctx, (*res)[0], req.State.Raw, (*res)[0].GetID(metadata.IdType))
+	resp.Diagnostics.Append(diags...)
+	resp.State.Raw = value
+}
+
+// Update Updating the resource in accordance with the framework schema.
+func (r *FrameworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	item, metadata, err := FrameworkResourceDataToMikrotik(ctx, r.schema, req.Plan.Raw, req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Plan conversion", err.Error())
+		return
+	}
+
+	var id string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID can be the name of a resource or its identifier. Mikrotik only operates on resource ID!
+	mikrotikId, err := r.lookupId(ctx, metadata, id)
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+		resp.Diagnostics.AddError("Update error", err.Error())
+		return
+	}
+
+	res, err := UpdateItem(&ItemId{Id, mikrotikId}, metadata.Path, item, r.client)
+	if err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+		resp.Diagnostics.AddError("Update error", err.Error())
+		return
+	}
+
+	// The name may have been changed.
+	if metadata.IdType == Name {
+		id = item.GetID(Name)
+	}
+
+	value, diags := r.state(ctx, res, req.Plan.Raw, id)
+	resp.Diagnostics.Append(diags...)
+	resp.State.Raw = value
+}
+
+// Delete Deleting the resource.
+func (r *FrameworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	metadata := FwGetMetadata(ctx, r.schema)
+
+	var id string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mikrotikId, err := r.lookupId(ctx, metadata, id)
+	if err != nil {
+		if err != errorNoLongerExists {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			resp.Diagnostics.AddError("Delete error", err.Error())
+			return
+		}
+
+		// We inform the user that the resource no longer exists.
+		resp.Diagnostics.AddWarning(errorNoLongerExists.Error(), "")
+		return
+	}
+
+	if err = DeleteItem(&ItemId{Id, mikrotikId}, metadata.Path, r.client); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+		resp.Diagnostics.AddError("Delete error", err.Error())
+	}
+}
+
+func (r *FrameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
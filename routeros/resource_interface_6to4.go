@@ -0,0 +1,74 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*A",
+    "actual-mtu": "1280",
+    "clamp-tcp-mss": "true",
+    "disabled": "false",
+    "dont-fragment": "no",
+    "dscp": "inherit",
+    "keepalive": "10s,10",
+    "local-address": "0.0.0.0",
+    "mtu": "auto",
+    "name": "sit1",
+    "remote-address": "192.88.99.1",
+    "running": "true"
+  }
+*/
+
+// ResourceInterface6to4 https://help.mikrotik.com/docs/display/ROS/6to4
+func ResourceInterface6to4() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/6to4"),
+		MetaId:           PropId(Name),
+		MetaSecrets:      PropSecrets(`"ipsec_secret"`),
+
+		KeyActualMtu:    PropActualMtuRo,
+		KeyClampTcpMss:  PropClampTcpMssRw,
+		KeyComment:      PropCommentRw,
+		KeyDisabled:     PropDisabledRw,
+		KeyDontFragment: PropDontFragmentRw,
+		KeyDscp:         PropDscpRw,
+		KeyIpsecSecret:  PropIpsecSecretRw,
+		KeyKeepalive:    PropKeepaliveRw,
+		"local_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "0.0.0.0",
+			Description:  "Source address of the tunnel packets, local on the router.",
+			ValidateFunc: validation.IsIPv4Address,
+		},
+		KeyMtu:  PropMtuRw(),
+		KeyName: PropNameForceNewRw,
+		"remote_address": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "IP address of the remote end of the 6to4 tunnel. If not set, the tunnel endpoint is " +
+				"derived from the IPv6 destination address.",
+			ValidateFunc: validation.IsIPv4Address,
+		},
+		KeyRunning:      PropRunningRo,
+		KeySecretHashes: PropSecretHashesRo,
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const test6to4Address = "routeros_interface_6to4.test"
+
+func TestAccInterface6to4Test_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/interface/6to4", "routeros_interface_6to4"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterface6to4Config("127.0.0.1"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckInterface6to4Exists(test6to4Address),
+							resource.TestCheckResourceAttr(test6to4Address, "name", "test_6to4"),
+							resource.TestCheckResourceAttr(test6to4Address, "remote_address", "127.0.0.1"),
+						),
+					},
+					{
+						Config: testAccInterface6to4Config("127.0.0.2"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(test6to4Address, "remote_address", "127.0.0.2"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckInterface6to4Exists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccInterface6to4Config(remoteAddress string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_interface_6to4" "test" {
+	name           = "test_6to4"
+	remote_address = "%v"
+	disabled       = true
+}
+`, remoteAddress)
+}
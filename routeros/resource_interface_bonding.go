@@ -38,6 +38,7 @@ func ResourceInterfaceBonding() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/bonding"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"arp_interval":"duration","down_delay":"duration","mii_interval":"duration","up_delay":"duration"`),
 
 		"arp": {
 			Type:     schema.TypeString,
@@ -52,11 +53,10 @@ func ResourceInterfaceBonding() *schema.Resource {
 			ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled", "proxy-arp", "reply-only"}, false),
 		},
 		"arp_interval": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Default:          "100ms",
-			Description:      "Time in milliseconds defines how often to monitor ARP requests.",
-			DiffSuppressFunc: TimeEquall,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "100ms",
+			Description: "Time in milliseconds defines how often to monitor ARP requests.",
 		},
 		"arp_ip_targets": {
 			Type:     schema.TypeString,
@@ -74,7 +74,6 @@ func ResourceInterfaceBonding() *schema.Resource {
 			Description: "If a link failure has been detected, the bonding interface is disabled for a down-delay " +
 				"time. The value should be a multiple of mii-interval, otherwise, it will be rounded down " +
 				"to the nearest value. This property only has an effect when link-monitoring is set to mii.",
-			DiffSuppressFunc: TimeEquall,
 		},
 		"forced_mac_address": {
 			Type:     schema.TypeString,
@@ -126,7 +125,6 @@ func ResourceInterfaceBonding() *schema.Resource {
 			Default:  "100ms",
 			Description: "How often to monitor the link for failures (the parameter used only if link-monitoring " +
 				"is mii)",
-			DiffSuppressFunc: TimeEquall,
 		},
 		"mlag_id": {
 			Type:     schema.TypeInt,
@@ -208,7 +206,6 @@ func ResourceInterfaceBonding() *schema.Resource {
 				"after this time it is enabled. The value should be a multiple of mii-interval , " +
 				"otherwise, it will be rounded down to the nearest value. This property only has an " +
 				"effect when link-monitoring is set to mii.",
-			DiffSuppressFunc: TimeEquall,
 		},
 		"transmit_hash_policy": {
 			Type:     schema.TypeString,
@@ -242,7 +239,7 @@ func ResourceInterfaceBonding() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -10,6 +10,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/bridge"),
 		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"admin_mac":"mac","last_member_interval":"duration","membership_interval":"duration","querier_interval":"duration","query_interval":"duration","query_response_interval":"duration","startup_query_interval":"duration"`),
 
 		KeyActualMtu: PropActualMtuRo,
 		"add_dhcp_option82": {
@@ -112,8 +113,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 			Description: "If a port has fast-leave set to no and a bridge port receives a IGMP Leave message, " +
 				"then a IGMP Snooping enabled bridge will send a IGMP query to make sure that no devices has " +
 				"subscribed to a certain multicast stream on a bridge port.",
-			DiffSuppressFunc: TimeEquall,
-			RequiredWith:     []string{"igmp_snooping"},
+			RequiredWith: []string{"igmp_snooping"},
 		},
 		"last_member_query_count": {
 			Type:     schema.TypeInt,
@@ -148,8 +148,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 			Computed: true,
 			Description: "Amount of time after an entry in the Multicast Database (MDB) is removed if a IGMP membership " +
 				"report is not received on a certain port. This property only has effect when igmp-snooping is set to yes.",
-			DiffSuppressFunc: TimeEquall,
-			RequiredWith:     []string{"igmp_snooping"},
+			RequiredWith: []string{"igmp_snooping"},
 		},
 		"mld_version": {
 			Type:     schema.TypeInt,
@@ -225,8 +224,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 			Computed: true,
 			Description: "Used to change the interval how often a bridge checks if it is the active multicast " +
 				"querier. This property only has effect when igmp-snooping and multicast-querier is set to yes.",
-			DiffSuppressFunc: TimeEquall,
-			RequiredWith:     []string{"igmp_snooping", "multicast_querier"},
+			RequiredWith: []string{"igmp_snooping", "multicast_querier"},
 		},
 		"query_interval": {
 			Type:     schema.TypeString,
@@ -235,8 +233,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 			Computed: true,
 			Description: "Used to change the interval how often IGMP general membership queries are sent out. " +
 				"This property only has effect when igmp-snooping and multicast-querier is set to yes.",
-			DiffSuppressFunc: TimeEquall,
-			RequiredWith:     []string{"igmp_snooping", "multicast_querier"},
+			RequiredWith: []string{"igmp_snooping", "multicast_querier"},
 		},
 		"query_response_interval": {
 			Type:     schema.TypeString,
@@ -245,8 +242,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 			Computed: true,
 			Description: "Interval in which a IGMP capable device must reply to a IGMP query with a IGMP membership " +
 				"report. This property only has effect when igmp-snooping and multicast-querier is set to yes.",
-			DiffSuppressFunc: TimeEquall,
-			RequiredWith:     []string{"igmp_snooping", "multicast_querier"},
+			RequiredWith: []string{"igmp_snooping", "multicast_querier"},
 		},
 		KeyRunning: PropRunningRo,
 		"region_name": {
@@ -278,8 +274,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 			Description: "Used to change the amount of time after a bridge starts sending out IGMP general membership " +
 				"queries after the bridge is enabled. This property only has effect when igmp-snooping and " +
 				"multicast-querier is set to yes.",
-			DiffSuppressFunc: TimeEquall,
-			RequiredWith:     []string{"igmp_snooping", "multicast_querier"},
+			RequiredWith: []string{"igmp_snooping", "multicast_querier"},
 		},
 		"transmit_hold_count": {
 			Type:         schema.TypeInt,
@@ -304,7 +299,7 @@ func ResourceInterfaceBridge() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -71,6 +71,8 @@ func ResourceInterfaceBridgePort() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/bridge/port"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"priority":"hex"`),
+		MetaReferences:   PropReferences(`"bridge":"/interface/bridge","interface":"/interface"`),
 
 		"nextid": {
 			Type:     schema.TypeString,
@@ -270,8 +272,7 @@ func ResourceInterfaceBridgePort() *schema.Resource {
 			Default:  128,
 			Description: "The priority of the interface, used by STP to determine the root port, " +
 				"used by MSTP to determine root port between regions.",
-			ValidateFunc:     validation.IntBetween(0, 240),
-			DiffSuppressFunc: HexEqual,
+			ValidateFunc: validation.IntBetween(0, 240),
 		},
 		"pvid": {
 			Type:     schema.TypeInt,
@@ -353,8 +354,9 @@ func ResourceInterfaceBridgePort() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
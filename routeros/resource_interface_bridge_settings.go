@@ -24,6 +24,8 @@ func ResourceInterfaceBridgeSettings() *schema.Resource {
 		MetaResourcePath: PropResourcePath("/interface/bridge/settings"),
 		MetaId:           PropId(Name),
 
+		KeyResetOnDestroy: PropResetOnDestroy,
+
 		"use_ip_firewall": {
 			Type:     schema.TypeBool,
 			Optional: true,
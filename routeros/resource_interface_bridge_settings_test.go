@@ -20,6 +20,7 @@ func TestAccInterfaceBridgeSettingsTest_basic(t *testing.T) {
 					testSetTransportEnv(t, name)
 				},
 				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testAccCheckInterfaceBridgeSettingsReset,
 				Steps: []resource.TestStep{
 					{
 						Config: testAccInterfaceBridgeSettingsConfig(name),
@@ -49,17 +50,33 @@ func testAccCheckInterfaceBridgeSettingsExists(name string) resource.TestCheckFu
 	}
 }
 
+// testAccCheckInterfaceBridgeSettingsReset The settings must be reset to the factory defaults on destroy.
+func testAccCheckInterfaceBridgeSettingsReset(s *terraform.State) error {
+	item, err := ReadSystemItem("/interface/bridge/settings", testAccProvider.Meta().(Client))
+	if err != nil {
+		return err
+	}
+
+	if BoolFromMikrotikJSON(item["use-ip-firewall"]) {
+		return fmt.Errorf("use-ip-firewall was not reset on destroy")
+	}
+
+	return nil
+}
+
 func testAccInterfaceBridgeSettingsConfig(testName string) string {
 	if strings.Contains(testName, "API") {
 		return providerConfig + `
 resource "routeros_interface_bridge_settings" "test" {
 	use_ip_firewall	= true
+	reset_on_destroy = true
 }
 `
 	}
 	return providerConfig + `
 resource "routeros_interface_bridge_settings" "test" {
 	use_ip_firewall	= false
+	reset_on_destroy = true
 }
 	`
 }
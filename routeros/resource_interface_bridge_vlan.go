@@ -27,6 +27,7 @@ func ResourceInterfaceBridgeVlan() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/bridge/vlan"),
 		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"bridge":"/interface/bridge","tagged":"/interface","untagged":"/interface"`),
 
 		"bridge": {
 			Type:        schema.TypeString,
@@ -82,8 +83,9 @@ func ResourceInterfaceBridgeVlan() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
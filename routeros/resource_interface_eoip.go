@@ -0,0 +1,128 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*8",
+    "actual-mtu": "1458",
+    "allow-fast-path": "true",
+    "arp": "enabled",
+    "arp-timeout": "auto",
+    "clamp-tcp-mss": "true",
+    "disabled": "false",
+    "dont-fragment": "no",
+    "dscp": "inherit",
+    "keepalive": "10s,10",
+    "l2mtu": "65535",
+    "local-address": "0.0.0.0",
+    "loop-protect": "default",
+    "loop-protect-disable-time": "5m",
+    "loop-protect-send-interval": "5s",
+    "loop-protect-status": "off",
+    "mac-address": "FE:51:8C:2A:7B:1D",
+    "mtu": "auto",
+    "name": "eoip-dc-1",
+    "remote-address": "10.77.3.26",
+    "running": "true",
+    "tunnel-id": "100"
+  }
+*/
+
+// ResourceInterfaceEoip https://help.mikrotik.com/docs/display/ROS/EoIP
+func ResourceInterfaceEoip() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/eoip"),
+		MetaId:           PropId(Name),
+		MetaSecrets:      PropSecrets(`"ipsec_secret"`),
+		MetaNormalize: PropNormalize(`"loop_protect_disable_time":"duration","loop_protect_send_interval":"duration",
+			"mac_address":"mac"`),
+
+		KeyActualMtu:     PropActualMtuRo,
+		KeyAllowFastPath: PropAllowFastPathRw,
+		KeyArp:           PropArpRw,
+		KeyArpTimeout:    PropArpTimeoutRw,
+		KeyClampTcpMss:   PropClampTcpMssRw,
+		KeyComment:       PropCommentRw,
+		KeyDisabled:      PropDisabledRw,
+		KeyDontFragment:  PropDontFragmentRw,
+		KeyDscp:          PropDscpRw,
+		KeyIpsecSecret:   PropIpsecSecretRw,
+		KeyKeepalive:     PropKeepaliveRw,
+		KeyL2Mtu:         PropL2MtuRo,
+		"local_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "0.0.0.0",
+			Description:  "Source address of the tunnel packets, local on the router.",
+			ValidateFunc: validation.IsIPv4Address,
+		},
+		"loop_protect": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Loop protection mode of the interface.",
+			ValidateFunc: validation.StringInSlice([]string{"default", "on", "off"}, false),
+		},
+		"loop_protect_disable_time": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The time the interface stays disabled when a loop is detected.",
+			ValidateFunc: ValidationTime,
+		},
+		"loop_protect_send_interval": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The interval of the loop protect packets.",
+			ValidateFunc: ValidationTime,
+		},
+		"loop_protect_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The loop protect status of the interface.",
+		},
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Media Access Control number of an interface. A random MAC address is assigned by default.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		KeyMtu:  PropMtuRw(),
+		KeyName: PropNameForceNewRw,
+		"remote_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IP address of the remote end of the EoIP tunnel.",
+			ValidateFunc: validation.IsIPv4Address,
+		},
+		KeyRunning:      PropRunningRo,
+		KeySecretHashes: PropSecretHashesRo,
+		"tunnel_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+			Description: "Unique tunnel identifier, which must match the other side of the tunnel. " +
+				"The tunnel ID is 0..65535.",
+			ValidateFunc: validation.IntBetween(0, 65535),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultValidateCreate(resSchema, TunnelIpsecValidate),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultValidateUpdate(resSchema, TunnelIpsecValidate),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
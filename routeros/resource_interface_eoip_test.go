@@ -0,0 +1,73 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testEoipAddress = "routeros_interface_eoip.test"
+
+func TestAccInterfaceEoipTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/interface/eoip", "routeros_interface_eoip"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterfaceEoipConfig("100"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckInterfaceEoipExists(testEoipAddress),
+							resource.TestCheckResourceAttr(testEoipAddress, "name", "test_eoip"),
+							resource.TestCheckResourceAttr(testEoipAddress, "tunnel_id", "100"),
+						),
+					},
+					{
+						Config: testAccInterfaceEoipConfig("200"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testEoipAddress, "tunnel_id", "200"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckInterfaceEoipExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccInterfaceEoipConfig(tunnelId string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_interface_eoip" "test" {
+	name           = "test_eoip"
+	remote_address = "127.0.0.1"
+	tunnel_id      = %v
+	disabled       = true
+}
+`, tunnelId)
+}
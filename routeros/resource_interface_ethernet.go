@@ -0,0 +1,395 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "advertise": "10M-baseT-half,10M-baseT-full,100M-baseT-half,100M-baseT-full,1G-baseT-half,1G-baseT-full",
+    "arp": "enabled",
+    "arp-timeout": "auto",
+    "auto-negotiation": "true",
+    "bandwidth": "unlimited/unlimited",
+    "default-name": "ether1",
+    "disabled": "false",
+    "driver-rx-byte": "9141378283",
+    "driver-rx-packet": "10452331",
+    "driver-tx-byte": "1620374727",
+    "driver-tx-packet": "5131577",
+    "full-duplex": "true",
+    "l2mtu": "1598",
+    "loop-protect": "default",
+    "loop-protect-disable-time": "5m",
+    "loop-protect-send-interval": "5s",
+    "loop-protect-status": "off",
+    "mac-address": "48:8F:5A:12:34:56",
+    "mtu": "1500",
+    "name": "ether1",
+    "orig-mac-address": "48:8F:5A:12:34:56",
+    "poe-out": "off",
+    "poe-priority": "10",
+    "running": "true",
+    "rx-flow-control": "off",
+    "slave": "false",
+    "speed": "1G-baseT-full",
+    "tx-flow-control": "off"
+  }
+*/
+
+// The traffic counters of the interface are not a part of the configuration.
+var reEthernetStatsFields = regexp.MustCompile(`^(driver-)?(rx|tx)-`)
+
+// ethernetStatsField Returns true for the traffic counters (rx-bytes, driver-tx-packet, ...) returned by the router.
+func ethernetStatsField(name string) bool {
+	return reEthernetStatsFields.MatchString(name) && name != "rx-flow-control" && name != "tx-flow-control"
+}
+
+// ResourceInterfaceEthernet https://help.mikrotik.com/docs/display/ROS/Ethernet
+func ResourceInterfaceEthernet() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/ethernet"),
+		MetaId:           PropId(Id),
+		MetaTransformSet: PropTransformSet(`"default-name": "factory-name"`),
+		MetaSkipFields:   PropSkipFields(`"factory_name"`),
+		MetaNormalize: PropNormalize(`"loop_protect_disable_time":"duration","loop_protect_send_interval":"duration",
+			"mac_address":"mac","power_cycle_interval":"duration","power_cycle_ping_timeout":"duration"`),
+
+		"advertise": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Advertised speed and duplex modes for Ethernet interfaces over twisted pair, only applies when auto-negotiation is enabled.",
+		},
+		KeyArp:        PropArpRw,
+		KeyArpTimeout: PropArpTimeoutRw,
+		"auto_negotiation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "When enabled, the interface advertises its maximum capabilities to achieve the best connection possible.",
+		},
+		"bandwidth": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Sets max rx/tx bandwidth in kbps that will be handled by an interface, e.g. `unlimited/unlimited`.",
+		},
+		"cable_settings": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Changes the cable length setting (only applicable to NS DP83815/6 cards).",
+			ValidateFunc: validation.StringInSlice([]string{"default", "short", "standard"}, false),
+		},
+		"combo_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Combo port mode: the copper or the SFP interface is used, or the one with the link (auto).",
+			ValidateFunc: validation.StringInSlice([]string{"auto", "copper", "sfp"}, false),
+		},
+		KeyComment: PropCommentRw,
+		"disable_running_check": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Disable running check. If this value is set to 'no', the router automatically detects whether the NIC is connected with a device in the network or not.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"factory_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			AtLeastOneOf: []string{"factory_name", KeyName},
+			Description: "The factory (default) name of the interface, e.g. `ether1`. The interface is adopted by this " +
+				"name when the resource is created. If not set, the interface is adopted by the current `name`.",
+		},
+		"full_duplex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defines whether the transmission of data appears in two directions simultaneously, only applies when auto-negotiation is disabled.",
+		},
+		"l2mtu": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Layer2 Maximum transmission unit.",
+		},
+		"loop_protect": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Loop protection mode of the interface.",
+			ValidateFunc: validation.StringInSlice([]string{"default", "on", "off"}, false),
+		},
+		"loop_protect_disable_time": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The time the interface stays disabled when a loop is detected.",
+			ValidateFunc: ValidationTime,
+		},
+		"loop_protect_send_interval": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The interval of the loop protect packets.",
+			ValidateFunc: ValidationTime,
+		},
+		"loop_protect_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The loop protect status of the interface.",
+		},
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Media Access Control number of an interface.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		KeyMtu: PropMtuRw(),
+		KeyName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the ethernet interface.",
+		},
+		"orig_mac_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Original Media Access Control number of an interface.",
+		},
+		"poe_out": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "PoE output mode, only on devices that support PoE-out.",
+			ValidateFunc: validation.StringInSlice([]string{"auto-on", "forced-on", "off"}, false),
+		},
+		"poe_priority": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "PoE-out priority, the lowest value means the highest priority. Only on devices that support PoE-out.",
+			ValidateFunc: validation.IntBetween(0, 99),
+		},
+		"power_cycle_interval": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Power cycle the PoE-out of the interface at this interval, `none` disables it.",
+		},
+		"power_cycle_ping_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The address to ping for the PoE-out power cycle.",
+			ValidateFunc: ValidationIpAddress,
+		},
+		"power_cycle_ping_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Power cycle the PoE-out of the interface when the ping address does not respond.",
+		},
+		"power_cycle_ping_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The time without a ping response after which the PoE-out is power cycled.",
+		},
+		"rate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The current link rate of the interface, empty if there is no link.",
+		},
+		KeyResetOnDestroy: PropResetOnDestroy,
+		KeyRunning:        PropRunningRo,
+		"rx_flow_control": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "When set to on, the port will process received pause frames and suspend transmission if required.",
+			ValidateFunc: validation.StringInSlice([]string{"auto", "on", "off"}, false),
+		},
+		"sfp_rate_select": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Allows to control rate select pin for SFP ports.",
+			ValidateFunc: validation.StringInSlice([]string{"high", "low"}, false),
+		},
+		"sfp_shutdown_temperature": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The temperature in Celsius at which the interface will be temporarily turned off due to too high detected SFP module temperature.",
+		},
+		"slave": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the interface is configured as a slave of another interface (e.g. bonding or bridge).",
+		},
+		"speed": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Sets interface data transmission speed which takes effect only when auto-negotiation is disabled.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The current link status of the interface (`link-ok`, `no-link`, ...).",
+		},
+		"switch": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The switch chip the interface belongs to.",
+		},
+		"tx_flow_control": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "When set to on, the port will generate pause frames to the upstream device to temporarily stop the packet transmission.",
+			ValidateFunc: validation.StringInSlice([]string{"auto", "on", "off"}, false),
+		},
+	}
+
+	// The factory settings of the interface, the name and the MAC address are taken from the router.
+	ethernetDefaults := MikrotikItem{
+		"auto-negotiation": "yes",
+		"comment":          "",
+		"loop-protect":     "default",
+		"mtu":              "1500",
+		"rx-flow-control":  "off",
+		"tx-flow-control":  "off",
+	}
+
+	resRead := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		metadata := GetMetadata(resSchema)
+
+		res, err := ReadItems(&ItemId{Id, d.Id()}, metadata.Path, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+			return diag.FromErr(err)
+		}
+
+		// Resource not found.
+		if len(*res) == 0 {
+			d.SetId("")
+			return nil
+		}
+
+		item := MikrotikItem{}
+		for k, v := range (*res)[0] {
+			if !ethernetStatsField(k) {
+				item[k] = v
+			}
+		}
+
+		diags := MikrotikResourceDataToTerraform(item, resSchema, d)
+
+		// The link status is only available with the 'monitor' command.
+		status, err := MonitorItem(&ItemId{Id, d.Id()}, metadata.Path, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		if err = d.Set("rate", status["rate"]); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		if err = d.Set("status", status["status"]); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+
+	resCreate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// The ethernet interfaces can not be created, the existing interface is adopted.
+		name := d.Get(KeyName).(string)
+		filter := []string{"name=" + name}
+		if factoryName := d.Get("factory_name").(string); factoryName != "" {
+			name = factoryName
+			filter = []string{"default-name=" + factoryName}
+		}
+
+		res, err := ReadItemsFiltered(filter, "/interface/ethernet", m.(Client))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(*res) != 1 {
+			return diag.Errorf("the ethernet interface '%v' was not found: the ethernet interfaces can not be created, "+
+				"only the existing interfaces can be managed", name)
+		}
+
+		d.SetId((*res)[0].GetID(Id))
+
+		if diags := ResourceUpdate(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
+
+		return resRead(ctx, d, m)
+	}
+
+	resUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if diags := ResourceUpdate(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
+
+		return resRead(ctx, d, m)
+	}
+
+	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if !d.Get(KeyResetOnDestroy).(bool) {
+			// The interface remains on the router with the last settings.
+			d.SetId("")
+			return DeleteSystemObject
+		}
+
+		item := resetItem(resSchema, ethernetDefaults)
+		item["name"] = d.Get("factory_name").(string)
+		item["mac-address"] = d.Get("orig_mac_address").(string)
+		// PoE-out is only reset on the devices that support it.
+		if d.Get("poe_out").(string) != "" {
+			item["poe-out"] = "auto-on"
+			item["poe-priority"] = "10"
+		}
+
+		if _, err := UpdateItem(&ItemId{Id, d.Id()}, "/interface/ethernet", item, m.(Client)); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		CreateContext: resCreate,
+		ReadContext:   resRead,
+		UpdateContext: resUpdate,
+		DeleteContext: resDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,98 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testInterfaceEthernetAddress = "routeros_interface_ethernet.test"
+
+func TestAccInterfaceEthernetTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				// The ethernet interfaces are not deleted.
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterfaceEthernetConfig("test ethernet"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckInterfaceEthernetExists(testInterfaceEthernetAddress),
+							resource.TestCheckResourceAttr(testInterfaceEthernetAddress, "name", "ether1"),
+							resource.TestCheckResourceAttr(testInterfaceEthernetAddress, "comment", "test ethernet"),
+							resource.TestCheckResourceAttr(testInterfaceEthernetAddress, "mtu", "1500"),
+							resource.TestCheckResourceAttrSet(testInterfaceEthernetAddress, "status"),
+						),
+					},
+					{
+						Config: testAccInterfaceEthernetConfig("test ethernet new"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testInterfaceEthernetAddress, "comment", "test ethernet new"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckInterfaceEthernetExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccInterfaceEthernetConfig(comment string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_interface_ethernet" "test" {
+	factory_name     = "ether1"
+	mtu              = "1500"
+	comment          = "%v"
+	reset_on_destroy = true
+}
+`, comment)
+}
+
+func Test_ethernetStatsField(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"rx-bytes", true},
+		{"tx-packet", true},
+		{"driver-rx-byte", true},
+		{"driver-tx-packet", true},
+		{"rx-flow-control", false},
+		{"tx-flow-control", false},
+		{"name", false},
+		{"orig-mac-address", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ethernetStatsField(tt.name); got != tt.want {
+				t.Errorf("ethernetStatsField(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
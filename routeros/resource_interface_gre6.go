@@ -0,0 +1,73 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*B",
+    "actual-mtu": "1456",
+    "clamp-tcp-mss": "true",
+    "disabled": "false",
+    "dscp": "inherit",
+    "keepalive": "10s,10",
+    "l2mtu": "65535",
+    "local-address": "::",
+    "mtu": "auto",
+    "name": "gre6-hq-1",
+    "remote-address": "2001:db8::1",
+    "running": "true"
+  }
+*/
+
+// ResourceInterfaceGre6 https://help.mikrotik.com/docs/display/ROS/GRE
+func ResourceInterfaceGre6() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/gre6"),
+		MetaId:           PropId(Name),
+		MetaSecrets:      PropSecrets(`"ipsec_secret"`),
+		MetaNormalize:    PropNormalize(`"local_address":"ip","remote_address":"ip"`),
+
+		KeyActualMtu:   PropActualMtuRo,
+		KeyClampTcpMss: PropClampTcpMssRw,
+		KeyComment:     PropCommentRw,
+		KeyDisabled:    PropDisabledRw,
+		KeyDscp:        PropDscpRw,
+		KeyIpsecSecret: PropIpsecSecretRw,
+		KeyKeepalive:   PropKeepaliveRw,
+		KeyL2Mtu:       PropL2MtuRo,
+		"local_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "::",
+			Description:  "Source address of the tunnel packets, local on the router.",
+			ValidateFunc: validation.IsIPv6Address,
+		},
+		KeyMtu:  PropMtuRw(),
+		KeyName: PropNameForceNewRw,
+		"remote_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IPv6 address of the remote end of the GRE6 tunnel.",
+			ValidateFunc: validation.IsIPv6Address,
+		},
+		KeyRunning:      PropRunningRo,
+		KeySecretHashes: PropSecretHashesRo,
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testGre6Address = "routeros_interface_gre6.test"
+
+func TestAccInterfaceGre6Test_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/interface/gre6", "routeros_interface_gre6"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterfaceGre6Config("2001:db8::1"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckInterfaceGre6Exists(testGre6Address),
+							resource.TestCheckResourceAttr(testGre6Address, "name", "test_gre6"),
+							resource.TestCheckResourceAttr(testGre6Address, "remote_address", "2001:db8::1"),
+						),
+					},
+					{
+						Config: testAccInterfaceGre6Config("2001:db8::2"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testGre6Address, "remote_address", "2001:db8::2"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckInterfaceGre6Exists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccInterfaceGre6Config(remoteAddress string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_interface_gre6" "test" {
+	name           = "test_gre6"
+	remote_address = "%v"
+	disabled       = true
+}
+`, remoteAddress)
+}
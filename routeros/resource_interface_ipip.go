@@ -0,0 +1,74 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*9",
+    "actual-mtu": "1480",
+    "allow-fast-path": "true",
+    "clamp-tcp-mss": "true",
+    "disabled": "false",
+    "dont-fragment": "no",
+    "dscp": "inherit",
+    "keepalive": "10s,10",
+    "local-address": "0.0.0.0",
+    "mtu": "auto",
+    "name": "ipip-hq-1",
+    "remote-address": "10.77.3.26",
+    "running": "true"
+  }
+*/
+
+// ResourceInterfaceIpip https://help.mikrotik.com/docs/display/ROS/IPIP
+func ResourceInterfaceIpip() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/ipip"),
+		MetaId:           PropId(Name),
+		MetaSecrets:      PropSecrets(`"ipsec_secret"`),
+
+		KeyActualMtu:     PropActualMtuRo,
+		KeyAllowFastPath: PropAllowFastPathRw,
+		KeyClampTcpMss:   PropClampTcpMssRw,
+		KeyComment:       PropCommentRw,
+		KeyDisabled:      PropDisabledRw,
+		KeyDontFragment:  PropDontFragmentRw,
+		KeyDscp:          PropDscpRw,
+		KeyIpsecSecret:   PropIpsecSecretRw,
+		KeyKeepalive:     PropKeepaliveRw,
+		"local_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "0.0.0.0",
+			Description:  "Source address of the tunnel packets, local on the router.",
+			ValidateFunc: validation.IsIPv4Address,
+		},
+		KeyMtu:  PropMtuRw(),
+		KeyName: PropNameForceNewRw,
+		"remote_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IP address of the remote end of the IPIP tunnel.",
+			ValidateFunc: validation.IsIPv4Address,
+		},
+		KeyRunning:      PropRunningRo,
+		KeySecretHashes: PropSecretHashesRo,
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultValidateCreate(resSchema, TunnelIpsecValidate),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultValidateUpdate(resSchema, TunnelIpsecValidate),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
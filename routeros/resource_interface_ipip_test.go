@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIpipAddress = "routeros_interface_ipip.test"
+
+func TestAccInterfaceIpipTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/interface/ipip", "routeros_interface_ipip"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterfaceIpipConfig("127.0.0.1"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckInterfaceIpipExists(testIpipAddress),
+							resource.TestCheckResourceAttr(testIpipAddress, "name", "test_ipip"),
+							resource.TestCheckResourceAttr(testIpipAddress, "remote_address", "127.0.0.1"),
+						),
+					},
+					{
+						Config: testAccInterfaceIpipConfig("127.0.0.2"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIpipAddress, "remote_address", "127.0.0.2"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckInterfaceIpipExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccInterfaceIpipConfig(remoteAddress string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_interface_ipip" "test" {
+	name           = "test_ipip"
+	remote_address = "%v"
+	disabled       = true
+}
+`, remoteAddress)
+}
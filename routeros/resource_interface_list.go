@@ -8,6 +8,7 @@ func ResourceInterfaceList() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/list"),
 		MetaId:           PropId(Name),
+		MetaNestedBlocks: PropNestedBlocks(`"members":"items:/interface/list/member:list"`),
 
 		"builtin": {
 			Type:     schema.TypeBool,
@@ -23,6 +24,30 @@ func ResourceInterfaceList() *schema.Resource {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"members": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Member ID.",
+					},
+					KeyComment:  PropCommentRw,
+					KeyDisabled: PropDisabledRw,
+					KeyInterface: {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the interface.",
+					},
+				},
+			},
+			Description: "The members of the list, stored as the items of '/interface/list/member'. Only the " +
+				"members created by this resource are managed and deleted, other members of the list are ignored. " +
+				"This field conflicts with `routeros_interface_list_member`: do not manage the members of the same " +
+				"list with both.",
+		},
 		"name": PropNameForceNewRw,
 	}
 
@@ -33,8 +58,9 @@ func ResourceInterfaceList() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -8,6 +8,7 @@ func ResourceInterfaceListMember() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/list/member"),
 		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"interface":"/interface","list":"/interface/list"`),
 
 		KeyDisabled: PropDisabledRw,
 		"dynamic": {
@@ -35,8 +36,9 @@ func ResourceInterfaceListMember() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -26,6 +26,8 @@ func TestAccInterfaceListTest_basic(t *testing.T) {
 						Check: resource.ComposeTestCheckFunc(
 							testAccCheckInterfaceListExists(testInterfaceListAddress),
 							resource.TestCheckResourceAttr(testInterfaceListAddress, "name", "test_list"),
+							resource.TestCheckResourceAttr(testInterfaceListAddress, "members.#", "1"),
+							resource.TestCheckResourceAttr(testInterfaceListAddress, "members.0.interface", "ether1"),
 						),
 					},
 				},
@@ -59,6 +61,9 @@ provider "routeros" {
 
 resource "routeros_interface_list" "test_list" {
 	name      = "test_list"
+	members {
+		interface = "ether1"
+	}
 }
 `
 }
@@ -35,6 +35,7 @@ func ResourceInterfacePPPoEClient() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/pppoe-client"),
 		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"interface":"/interface","profile":"/ppp/profile"`),
 
 		"ac_name": {
 			Type:     schema.TypeString,
@@ -152,8 +153,9 @@ func ResourceInterfacePPPoEClient() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -2,7 +2,6 @@ package routeros
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 /*
@@ -27,7 +26,7 @@ func ResourceInterfaceVeth() *schema.Resource {
 			Type:         schema.TypeString,
 			Required:     true,
 			Description:  "IP address.",
-			ValidateFunc: validation.IsCIDR,
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpPrefix),
 		},
 		KeyComment:  PropCommentRw,
 		KeyDisabled: PropDisabledRw,
@@ -35,7 +34,7 @@ func ResourceInterfaceVeth() *schema.Resource {
 			Type:         schema.TypeString,
 			Required:     true,
 			Description:  "Gateway IP address.",
-			ValidateFunc: validation.IsIPv4Address,
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress),
 		},
 		KeyName:    PropName("Interface name."),
 		KeyRunning: PropRunningRo,
@@ -48,7 +47,7 @@ func ResourceInterfaceVeth() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -10,6 +10,8 @@ func ResourceInterfaceVlan() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/vlan"),
 		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"loop_protect_disable_time":"duration","loop_protect_send_interval":"duration"`),
+		MetaReferences:   PropReferences(`"interface":"/interface"`),
 
 		KeyArp:        PropArpRw,
 		KeyArpTimeout: PropArpTimeoutRw,
@@ -24,18 +26,16 @@ func ResourceInterfaceVlan() *schema.Resource {
 			ValidateFunc: validation.StringInSlice([]string{"default", "on", "off"}, false),
 		},
 		"loop_protect_disable_time": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Default:          "5m",
-			ValidateFunc:     ValidationTime,
-			DiffSuppressFunc: TimeEquall,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "5m",
+			ValidateFunc: ValidationTime,
 		},
 		"loop_protect_send_interval": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Default:          "5s",
-			ValidateFunc:     ValidationTime,
-			DiffSuppressFunc: TimeEquall,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "5s",
+			ValidateFunc: ValidationTime,
 		},
 		"loop_protect_status": {
 			Type:     schema.TypeString,
@@ -63,8 +63,9 @@ func ResourceInterfaceVlan() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -42,6 +42,7 @@ func ResourceInterfaceVrrp() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/vrrp"),
 		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"interface":"/interface"`),
 
 		KeyArp:        PropArpRw,
 		KeyArpTimeout: PropArpTimeoutRw,
@@ -195,8 +196,9 @@ func ResourceInterfaceVrrp() *schema.Resource {
 		}),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
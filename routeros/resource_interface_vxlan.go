@@ -0,0 +1,166 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*C",
+    "allow-fast-path": "true",
+    "arp": "enabled",
+    "arp-timeout": "auto",
+    "disabled": "false",
+    "dont-fragment": "auto",
+    "group": "",
+    "interface": "",
+    "l2mtu": "65535",
+    "learning": "true",
+    "local-address": "",
+    "loop-protect": "default",
+    "loop-protect-disable-time": "5m",
+    "loop-protect-send-interval": "5s",
+    "loop-protect-status": "off",
+    "mac-address": "2A:6F:1D:3C:48:E1",
+    "max-fdb-size": "4096",
+    "mtu": "1500",
+    "name": "vxlan-fabric",
+    "port": "8472",
+    "running": "true",
+    "vni": "10100",
+    "vrf": "main",
+    "vteps-ip-version": "ipv4"
+  }
+*/
+
+// ResourceInterfaceVxlan https://help.mikrotik.com/docs/display/ROS/VXLAN
+func ResourceInterfaceVxlan() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/vxlan"),
+		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"interface":"/interface"`),
+		MetaNormalize: PropNormalize(`"loop_protect_disable_time":"duration","loop_protect_send_interval":"duration",
+			"mac_address":"mac"`),
+
+		KeyAllowFastPath: PropAllowFastPathRw,
+		KeyArp:           PropArpRw,
+		KeyArpTimeout:    PropArpTimeoutRw,
+		KeyComment:       PropCommentRw,
+		KeyDisabled:      PropDisabledRw,
+		KeyDontFragment: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The Don't Fragment (DF) flag of the encapsulated packets.",
+			ValidateFunc: validation.StringInSlice([]string{"auto", "disabled", "enabled", "inherit"}, false),
+		},
+		"group": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "When specified, a multicast group address can be used to forward broadcast, unknown-unicast, and multicast traffic between VTEPs.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress),
+		},
+		KeyInterface: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Interface name used for multicast forwarding. This property requires the 'group' setting to be configured.",
+		},
+		KeyL2Mtu: PropL2MtuRo,
+		"learning": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enables or disables the learning of the remote MAC addresses into the VXLAN forwarding table.",
+		},
+		"local_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the local source address for the VXLAN. If not set, one of the local addresses of the outgoing interface is used.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress | IpEmpty),
+		},
+		"loop_protect": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Loop protection mode of the interface.",
+			ValidateFunc: validation.StringInSlice([]string{"default", "on", "off"}, false),
+		},
+		"loop_protect_disable_time": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The time the interface stays disabled when a loop is detected.",
+			ValidateFunc: ValidationTime,
+		},
+		"loop_protect_send_interval": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The interval of the loop protect packets.",
+			ValidateFunc: ValidationTime,
+		},
+		"loop_protect_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The loop protect status of the interface.",
+		},
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Static MAC address of the interface. A randomly generated MAC address will be assigned when not specified.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		"max_fdb_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Limits the maximum number of MAC addresses that VXLAN can store in the forwarding database (FDB).",
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		KeyMtu:  PropMtuRw(),
+		KeyName: PropNameForceNewRw,
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Used UDP port number.",
+			ValidateFunc: validation.IsPortNumber,
+		},
+		KeyRunning: PropRunningRo,
+		"vni": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "VXLAN Network Identifier (VNI), 1..16777216.",
+			ValidateFunc: validation.IntBetween(1, 16777216),
+		},
+		"vrf": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Set VRF for the VXLAN interface on which it listens for and sends the encapsulated packets.",
+		},
+		"vteps_ip_version": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The IP protocol version of the VTEPs, used for the dynamically discovered VTEPs and the multicast group.",
+			ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
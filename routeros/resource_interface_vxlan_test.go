@@ -0,0 +1,85 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testInterfaceVxlanAddress      = "routeros_interface_vxlan.test"
+	testInterfaceVxlanVtepsAddress = "routeros_interface_vxlan_vteps.test"
+)
+
+func TestAccInterfaceVxlanTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/interface/vxlan/vteps", "routeros_interface_vxlan_vteps"),
+					testCheckResourceDestroy("/interface/vxlan", "routeros_interface_vxlan"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterfaceVxlanConfig(10100),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckInterfaceVxlanExists(testInterfaceVxlanAddress),
+							testAccCheckInterfaceVxlanExists(testInterfaceVxlanVtepsAddress),
+							resource.TestCheckResourceAttr(testInterfaceVxlanAddress, "name", "test_vxlan"),
+							resource.TestCheckResourceAttr(testInterfaceVxlanAddress, "vni", "10100"),
+							resource.TestCheckResourceAttr(testInterfaceVxlanVtepsAddress, "remote_ip", "127.0.0.1"),
+						),
+					},
+					{
+						Config: testAccInterfaceVxlanConfig(10200),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testInterfaceVxlanAddress, "vni", "10200"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckInterfaceVxlanExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccInterfaceVxlanConfig(vni int) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_interface_vxlan" "test" {
+	name     = "test_vxlan"
+	vni      = %v
+	disabled = true
+}
+
+resource "routeros_interface_vxlan_vteps" "test" {
+	interface = routeros_interface_vxlan.test.name
+	remote_ip = "127.0.0.1"
+}
+`, vni)
+}
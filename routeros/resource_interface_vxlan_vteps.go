@@ -0,0 +1,59 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "interface": "vxlan-fabric",
+    "port": "8472",
+    "remote-ip": "10.0.0.2"
+  }
+*/
+
+// ResourceInterfaceVxlanVteps https://help.mikrotik.com/docs/display/ROS/VXLAN
+func ResourceInterfaceVxlanVteps() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/vxlan/vteps"),
+		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"interface":"/interface/vxlan"`),
+		MetaNormalize:    PropNormalize(`"remote_ip":"ip"`),
+
+		KeyComment: PropCommentRw,
+		KeyInterface: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the VXLAN interface.",
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Used UDP port number.",
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"remote_ip": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The IPv4 or IPv6 destination address of the remote VTEP.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -12,6 +12,7 @@ func ResourceInterfaceWireguardPeer() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wireguard/peers"),
 		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"interface":"/interface/wireguard"`),
 
 		"allowed_address": {
 			Type:     schema.TypeList,
@@ -20,8 +21,8 @@ func ResourceInterfaceWireguardPeer() *schema.Resource {
 				"is allowed and to which outgoing traffic for this peer is directed. The catch-all 0.0.0.0/0 may be " +
 				"specified for matching all IPv4 addresses, and ::/0 may be specified for matching all IPv6 addresses.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
-				// ValidateFunc: ValidationIpAddress,
+				Type:         schema.TypeString,
+				ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix),
 			},
 		},
 		"current_endpoint_address": {
@@ -96,8 +97,9 @@ func ResourceInterfaceWireguardPeer() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -0,0 +1,343 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*3",
+    "arp": "enabled",
+    "arp-timeout": "auto",
+    "band": "2ghz-b/g/n",
+    "channel-width": "20/40mhz-XX",
+    "country": "latvia",
+    "default-authentication": "true",
+    "default-forwarding": "true",
+    "default-name": "wlan1",
+    "disabled": "false",
+    "frequency": "auto",
+    "hide-ssid": "false",
+    "installation": "indoor",
+    "interface-type": "IPQ4019",
+    "l2mtu": "1600",
+    "mac-address": "48:8F:5A:12:34:56",
+    "master-interface": "",
+    "mode": "ap-bridge",
+    "mtu": "1500",
+    "name": "wlan1",
+    "radio-name": "488F5A123456",
+    "running": "true",
+    "security-profile": "home",
+    "ssid": "home",
+    "vlan-id": "1",
+    "vlan-mode": "no-tag",
+    "wireless-protocol": "802.11",
+    "wps-mode": "disabled"
+  }
+*/
+
+// ResourceInterfaceWireless https://wiki.mikrotik.com/wiki/Manual:Interface/Wireless
+func ResourceInterfaceWireless() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wireless"),
+		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"disconnect_timeout":"duration"`),
+		MetaReferences: PropReferences(`"master_interface":"/interface/wireless",
+			"security_profile":"/interface/wireless/security-profiles"`),
+
+		"antenna_gain": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Antenna gain in dBi, used to calculate maximum transmit power according to country regulations.",
+		},
+		KeyArp:        PropArpRw,
+		KeyArpTimeout: PropArpTimeoutRw,
+		"band": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Defines set of used data rates, channel frequencies and widths.",
+			ValidateFunc: validation.StringInSlice([]string{"2ghz-b", "2ghz-b/g", "2ghz-b/g/n", "2ghz-onlyg",
+				"2ghz-onlyn", "2ghz-g/n", "5ghz-a", "5ghz-a/n", "5ghz-a/n/ac", "5ghz-onlyn", "5ghz-onlyac",
+				"5ghz-n/ac"}, false),
+		},
+		"bridge_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Allows to use station-bridge mode.",
+			ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+		},
+		"channel_width": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Use of extension channels: `20mhz`, `20/40mhz-XX`, `20/40/80mhz-XXXX`.",
+		},
+		KeyComment: PropCommentRw,
+		"country": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Limits available bands, frequencies and maximum transmit power for each frequency: `latvia`, " +
+				"`united states`, `etsi`.",
+		},
+		"default_authentication": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+			Description: "For AP mode, this is the value of authentication for clients that do not match any entry " +
+				"in the access-list. For station mode, this is the value of connect for APs that do not match any " +
+				"entry in the connect-list.",
+		},
+		"default_forwarding": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "This is the value of forwarding for clients that do not match any entry in the access-list.",
+		},
+		"default_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The default name of the physical radio.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"disconnect_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The time interval after which the connection is closed if no frame can be transmitted.",
+		},
+		"distance": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "How long to wait for confirmation of unicast frames before considering transmission unsuccessful: `indoors`, `dynamic` or the distance in km.",
+		},
+		"frequency": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Channel frequency value in MHz on which AP will operate: `2412` or `auto`.",
+		},
+		"frequency_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Three frequency modes are available: regulatory-domain, manual-txpower, superchannel.",
+			ValidateFunc: validation.StringInSlice([]string{"manual-txpower", "regulatory-domain", "superchannel"}, false),
+		},
+		"hide_ssid": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Do not include the SSID in the beacon frames and do not respond to the probe requests without the SSID.",
+		},
+		"installation": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Adjusts scan-list to use indoor, outdoor or all frequencies for the country that is set.",
+			ValidateFunc: validation.StringInSlice([]string{"any", "indoor", "outdoor"}, false),
+		},
+		"interface_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The chipset of the physical radio or `virtual` for the virtual APs.",
+		},
+		"keepalive_frames": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Whether to send keepalive frames to the idle clients.",
+			ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+		},
+		KeyL2Mtu: PropL2MtuRo,
+		KeyMacAddress: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "MAC address of the interface.",
+		},
+		"master_interface": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Description: "The physical radio of the virtual AP. When the value is empty, the existing physical radio " +
+				"with the same name is adopted: its settings are changed, the radio is never created or removed.",
+		},
+		"max_station_count": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Maximum number of associated clients.",
+			ValidateFunc: validation.IntBetween(1, 2007),
+		},
+		"mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Selection between different station and access point (AP) modes.",
+			ValidateFunc: validation.StringInSlice([]string{"alignment-only", "ap-bridge", "bridge",
+				"nstreme-dual-slave", "station", "station-bridge", "station-pseudobridge",
+				"station-pseudobridge-clone", "station-wds", "wds-slave"}, false),
+		},
+		KeyMtu: PropMtuRw(),
+		"multicast_helper": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The conversion of the multicast frames to the unicast frames.",
+			ValidateFunc: validation.StringInSlice([]string{"default", "disabled", "full"}, false),
+		},
+		KeyName: PropNameForceNewRw,
+		"radio_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Descriptive name of the device, that is shown in registration table entries on the remote devices.",
+		},
+		KeyRunning: PropRunningRo,
+		"scan_list": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The frequencies to scan: `default`, `2412,2437,2462`, `5180-5320`.",
+		},
+		"security_profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the security profile (`routeros_interface_wireless_security_profiles`).",
+		},
+		"skip_dfs_channels": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Whether to skip the DFS channels.",
+			ValidateFunc: validation.StringInSlice([]string{"10min-cac", "all", "disabled"}, false),
+		},
+		"ssid": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "SSID (service set identifier) is a name that identifies wireless network.",
+			ValidateFunc: validation.StringLenBetween(0, 32),
+		},
+		"station_roaming": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Station roaming in the station mode.",
+			ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+		},
+		"tx_power": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Transmit power in dBm, the value is used according to the `tx_power_mode`.",
+		},
+		"tx_power_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Sets up tx-power mode for the radio.",
+			ValidateFunc: validation.StringInSlice([]string{"all-rates-fixed", "card-rates", "default", "manual-table"}, false),
+		},
+		"vlan_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "VLAN ID to use if the interface is in `use-tag` VLAN mode.",
+			ValidateFunc: validation.IntBetween(1, 4095),
+		},
+		"vlan_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "VLAN tagging mode specifies if VLAN tag should be assigned to the traffic.",
+			ValidateFunc: validation.StringInSlice([]string{"no-tag", "use-service-tag", "use-tag"}, false),
+		},
+		"wds_default_bridge": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "When the WDS link is established, the WDS interface is added to this bridge.",
+		},
+		"wds_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Controls how the WDS links with other devices are established.",
+			ValidateFunc: validation.StringInSlice([]string{"disabled", "dynamic", "dynamic-mesh", "static", "static-mesh"}, false),
+		},
+		"wireless_protocol": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies protocol used on wireless interface.",
+			ValidateFunc: validation.StringInSlice([]string{"802.11", "any", "nstreme", "nv2", "nv2-nstreme",
+				"nv2-nstreme-802.11", "unspecified"}, false),
+		},
+		"wps_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "WPS server mode.",
+			ValidateFunc: validation.StringInSlice([]string{"disabled", "push-button", "push-button-virtual-only"}, false),
+		},
+	}
+
+	resCreate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// Virtual APs are created on top of the physical radio.
+		if d.Get("master_interface").(string) != "" {
+			return ResourceCreate(ctx, resSchema, d, m)
+		}
+
+		// The physical radios can not be created, the existing radio is adopted by name.
+		name := d.Get(KeyName).(string)
+		res, err := ReadItems(&ItemId{Name, name}, "/interface/wireless", m.(Client))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(*res) == 0 {
+			return diag.Errorf("the wireless interface '%v' was not found: the physical radios can not be created, "+
+				"set 'master_interface' to create a virtual AP", name)
+		}
+
+		d.SetId(name)
+
+		return ResourceUpdate(ctx, resSchema, d, m)
+	}
+
+	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.Get("master_interface").(string) != "" {
+			return ResourceDelete(ctx, resSchema, d, m)
+		}
+
+		// The physical radio remains on the router with the last settings.
+		d.SetId("")
+		return DeleteSystemObject
+	}
+
+	return &schema.Resource{
+		CreateContext: resCreate,
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: resDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
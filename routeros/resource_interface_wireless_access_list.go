@@ -0,0 +1,130 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "allow-signal-out-of-range": "10s",
+    "ap-tx-limit": "0",
+    "authentication": "true",
+    "client-tx-limit": "0",
+    "disabled": "false",
+    "forwarding": "true",
+    "interface": "wlan1",
+    "mac-address": "00:11:22:33:44:55",
+    "management-protection-key": "",
+    "private-algo": "none",
+    "private-key": "",
+    "private-pre-shared-key": "device-key",
+    "signal-range": "-79..120",
+    "time": "0s-1d,sun,mon,tue,wed,thu,fri,sat",
+    "vlan-id": "1",
+    "vlan-mode": "default"
+  }
+*/
+
+// ResourceInterfaceWirelessAccessList https://wiki.mikrotik.com/wiki/Manual:Interface/Wireless#Access_List
+func ResourceInterfaceWirelessAccessList() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wireless/access-list"),
+		MetaId:           PropId(Id),
+		MetaSecrets:      PropSecrets(`"management_protection_key","private_pre_shared_key"`),
+
+		"allow_signal_out_of_range": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "An option that permits the client to remain connected while its signal is out of the " +
+				"`signal_range` for the set period: `10s` or `always`.",
+		},
+		"ap_tx_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Limit rate of data transmission to this client, bits per second. `0` means no limit.",
+		},
+		"authentication": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Accept the client when it tries to connect, `false` rejects the client.",
+		},
+		"client_tx_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Ask the client to limit the rate of data transmission, bits per second. `0` means no limit.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"forwarding": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Forward the frames of the client to other wireless clients.",
+		},
+		"interface": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Rules with interface=any are used for any wireless interface.",
+		},
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Rule matches client with the specified MAC address.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		"management_protection_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The management protection shared secret of the client.",
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"private_pre_shared_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The pre-shared key of the client, used instead of the key of the security profile.",
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"signal_range": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Rule matches if the signal strength of the received packets is within the range: `-79..120`.",
+		},
+		"time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Rule will match only during specified time and days of the week: " +
+				"`7h-19h,mon,tue,wed,thu,fri`.",
+		},
+		"vlan_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "VLAN ID to use if `vlan_mode` enables use of VLAN tagging.",
+			ValidateFunc: validation.IntBetween(1, 4095),
+		},
+		"vlan_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "VLAN tagging mode specifies if traffic coming from the client should get tagged.",
+			ValidateFunc: validation.StringInSlice([]string{"default", "no-tag", "use-service-tag", "use-tag"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,102 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "3gpp": "",
+    "area-prefix": "",
+    "connect": "true",
+    "disabled": "false",
+    "interface": "wlan1",
+    "interworking": "any",
+    "mac-address": "00:00:00:00:00:00",
+    "security-profile": "home",
+    "signal-range": "-120..120",
+    "ssid": "uplink",
+    "wireless-protocol": "any"
+  }
+*/
+
+// ResourceInterfaceWirelessConnectList https://wiki.mikrotik.com/wiki/Manual:Interface/Wireless#Connect_List
+func ResourceInterfaceWirelessConnectList() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wireless/connect-list"),
+		MetaId:           PropId(Id),
+		MetaReferences: PropReferences(`"interface":"/interface/wireless",
+			"security_profile":"/interface/wireless/security-profiles"`),
+
+		"area_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Rule matches if the area value of the AP (a proprietary extension) begins with the specified value.",
+		},
+		KeyComment: PropCommentRw,
+		"connect": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Available options: true - connect to the access point that matches this rule, false - do not connect.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"interface": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Each rule in the connect list applies only to one wireless interface.",
+		},
+		"interworking": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Rule matches if the AP supports the interworking (802.11u) or not.",
+			ValidateFunc: validation.StringInSlice([]string{"any", "no", "yes"}, false),
+		},
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Rule matches only AP with the specified MAC address.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"security_profile": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Name of the security profile (`routeros_interface_wireless_security_profiles`) used to " +
+				"connect to the matching AP, `none` uses the profile of the interface.",
+		},
+		"signal_range": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Rule matches if the signal strength of the AP is within the range: `-80..120`.",
+		},
+		"ssid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Rule matches access points that have this SSID, empty value matches any SSID.",
+		},
+		"wireless_protocol": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Rule matches the AP that uses the specified protocol.",
+			ValidateFunc: validation.StringInSlice([]string{"802.11", "any", "nstreme", "nv2", "nv2-nstreme",
+				"nv2-nstreme-802.11", "tdma", "unspecified"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,221 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "authentication-types": "wpa2-psk",
+    "default": "false",
+    "disable-pmkid": "false",
+    "eap-methods": "passthrough",
+    "group-ciphers": "aes-ccm",
+    "group-key-update": "5m",
+    "interim-update": "0s",
+    "management-protection": "disabled",
+    "management-protection-key": "",
+    "mode": "dynamic-keys",
+    "mschapv2-password": "",
+    "mschapv2-username": "",
+    "name": "home",
+    "radius-called-format": "mac:ssid",
+    "radius-eap-accounting": "false",
+    "radius-mac-accounting": "false",
+    "radius-mac-authentication": "false",
+    "radius-mac-caching": "disabled",
+    "radius-mac-format": "XX:XX:XX:XX:XX:XX",
+    "radius-mac-mode": "as-username",
+    "supplicant-identity": "MikroTik",
+    "tls-certificate": "none",
+    "tls-mode": "no-certificates",
+    "unicast-ciphers": "aes-ccm",
+    "wpa-pre-shared-key": "",
+    "wpa2-pre-shared-key": "secret-key"
+  }
+*/
+
+// ResourceInterfaceWirelessSecurityProfiles https://wiki.mikrotik.com/wiki/Manual:Interface/Wireless#Security_Profiles
+func ResourceInterfaceWirelessSecurityProfiles() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wireless/security-profiles"),
+		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"group_key_update":"duration","interim_update":"duration"`),
+		MetaSecrets: PropSecrets(`"management_protection_key","mschapv2_password","wpa_pre_shared_key",
+			"wpa2_pre_shared_key"`),
+
+		"authentication_types": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Set of supported authentication types.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"wpa-eap", "wpa-psk", "wpa2-eap", "wpa2-psk"}, false),
+			},
+		},
+		KeyComment: PropCommentRw,
+		"default": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The default security profile.",
+		},
+		"disable_pmkid": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to include PMKID into the EAPOL frame sent out by the AP.",
+		},
+		"eap_methods": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "EAP methods to use for the authentication.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"eap-tls", "eap-ttls-mschapv2", "passthrough",
+					"peap"}, false),
+			},
+		},
+		"group_ciphers": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Access Point advertises one of these ciphers, multiple values can be selected.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"aes-ccm", "tkip"}, false),
+			},
+		},
+		"group_key_update": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Controls how often Access Point updates the group key.",
+		},
+		"interim_update": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "When RADIUS accounting is used, Access Point periodically sends accounting information updates to the RADIUS server.",
+		},
+		"management_protection": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Management frame protection.",
+			ValidateFunc: validation.StringInSlice([]string{"allowed", "disabled", "required"}, false),
+		},
+		"management_protection_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The management protection shared secret.",
+		},
+		"mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Encryption mode for the security profile.",
+			ValidateFunc: validation.StringInSlice([]string{"dynamic-keys", "none", "static-keys-optional",
+				"static-keys-required"}, false),
+		},
+		"mschapv2_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Password to use for the authentication when the `eap-ttls-mschapv2` method is being used.",
+		},
+		"mschapv2_username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Username to use for the authentication when the `eap-ttls-mschapv2` method is being used.",
+		},
+		KeyName: PropName("Name of the security profile."),
+		"radius_called_format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The format of the Called-Station-Id RADIUS attribute.",
+			ValidateFunc: validation.StringInSlice([]string{"mac", "mac:ssid", "ssid"}, false),
+		},
+		"radius_eap_accounting": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to send the RADIUS accounting for the EAP-authenticated clients.",
+		},
+		"radius_mac_accounting": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to send the RADIUS accounting for the MAC-authenticated clients.",
+		},
+		"radius_mac_authentication": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "This property affects the way how Access Point processes clients that are not found in the Access List.",
+		},
+		"radius_mac_caching": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "How long the RADIUS MAC authentication result is cached: `disabled` or the time interval.",
+		},
+		"radius_mac_format": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Controls how the MAC address of the client is encoded in the User-Name attribute: `XX:XX:XX:XX:XX:XX`.",
+		},
+		"radius_mac_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The password sent in the Access-Request during the MAC authentication.",
+			ValidateFunc: validation.StringInSlice([]string{"as-username", "as-username-and-password"}, false),
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"supplicant_identity": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "EAP identity that is sent by the client at the beginning of the EAP authentication.",
+		},
+		"tls_certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The certificate for the TLS based EAP methods: `none` or the certificate name.",
+		},
+		"tls_mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The verification of the remote certificate in the TLS based EAP methods.",
+			ValidateFunc: validation.StringInSlice([]string{"dont-verify-certificate", "no-certificates",
+				"verify-certificate", "verify-certificate-with-crl"}, false),
+		},
+		"unicast_ciphers": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Access Point advertises that it supports specified ciphers, multiple values can be selected.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"aes-ccm", "tkip"}, false),
+			},
+		},
+		"wpa_pre_shared_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "WPA pre-shared key mode requires all devices in a BSS to have common secret key.",
+		},
+		"wpa2_pre_shared_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "WPA2 pre-shared key mode requires all devices in a BSS to have common secret key.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,109 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testInterfaceWirelessAddress                 = "routeros_interface_wireless.test"
+	testInterfaceWirelessSecurityProfilesAddress = "routeros_interface_wireless_security_profiles.test"
+	testInterfaceWirelessAccessListAddress       = "routeros_interface_wireless_access_list.test"
+	testInterfaceWirelessConnectListAddress      = "routeros_interface_wireless_connect_list.test"
+)
+
+func TestAccInterfaceWirelessTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/interface/wireless/connect-list", "routeros_interface_wireless_connect_list"),
+					testCheckResourceDestroy("/interface/wireless/access-list", "routeros_interface_wireless_access_list"),
+					testCheckResourceDestroy("/interface/wireless", "routeros_interface_wireless"),
+					testCheckResourceDestroy("/interface/wireless/security-profiles", "routeros_interface_wireless_security_profiles"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterfaceWirelessConfig("test_ssid"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckInterfaceWirelessExists(testInterfaceWirelessAddress),
+							testAccCheckInterfaceWirelessExists(testInterfaceWirelessSecurityProfilesAddress),
+							testAccCheckInterfaceWirelessExists(testInterfaceWirelessAccessListAddress),
+							testAccCheckInterfaceWirelessExists(testInterfaceWirelessConnectListAddress),
+							resource.TestCheckResourceAttr(testInterfaceWirelessAddress, "ssid", "test_ssid"),
+							resource.TestCheckResourceAttr(testInterfaceWirelessAddress, "interface_type", "virtual"),
+							resource.TestCheckResourceAttr(testInterfaceWirelessSecurityProfilesAddress, "mode", "dynamic-keys"),
+							resource.TestCheckResourceAttr(testInterfaceWirelessAccessListAddress, "signal_range", "-79..120"),
+						),
+					},
+					{
+						Config: testAccInterfaceWirelessConfig("test_ssid_new"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testInterfaceWirelessAddress, "ssid", "test_ssid_new"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckInterfaceWirelessExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccInterfaceWirelessConfig(ssid string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_interface_wireless_security_profiles" "test" {
+	name                 = "test_wireless_profile"
+	mode                 = "dynamic-keys"
+	authentication_types = ["wpa2-psk"]
+	wpa2_pre_shared_key  = "test_wireless_key"
+}
+
+resource "routeros_interface_wireless" "test" {
+	name             = "test_wireless_vap"
+	master_interface = "wlan1"
+	mode             = "ap-bridge"
+	ssid             = "%v"
+	security_profile = routeros_interface_wireless_security_profiles.test.name
+}
+
+resource "routeros_interface_wireless_access_list" "test" {
+	interface              = routeros_interface_wireless.test.name
+	mac_address            = "00:11:22:33:44:55"
+	signal_range           = "-79..120"
+	private_pre_shared_key = "test_device_key"
+}
+
+resource "routeros_interface_wireless_connect_list" "test" {
+	interface        = "wlan1"
+	ssid             = "test_uplink"
+	security_profile = routeros_interface_wireless_security_profiles.test.name
+}
+`, ssid)
+}
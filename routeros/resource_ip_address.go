@@ -22,13 +22,14 @@ import (
 func ResourceIPAddress() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/address"),
-		MetaId:           PropId(Id),
+		MetaId:           PropId(CommentTag),
+		MetaReferences:   PropReferences(`"interface":"/interface"`),
 
 		"address": {
 			Type:         schema.TypeString,
 			Required:     true,
 			Description:  "IP address.",
-			ValidateFunc: ValidationIpAddress,
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix),
 		},
 		"actual_interface": {
 			Type:        schema.TypeString,
@@ -56,8 +57,9 @@ func ResourceIPAddress() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
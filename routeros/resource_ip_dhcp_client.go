@@ -10,6 +10,7 @@ func ResourceDhcpClient() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/dhcp-client"),
 		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"interface":"/interface"`),
 
 		"add_default_route": {
 			Type:         schema.TypeString,
@@ -109,8 +110,9 @@ func ResourceDhcpClient() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
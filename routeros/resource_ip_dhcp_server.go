@@ -10,6 +10,7 @@ func ResourceDhcpServer() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/dhcp-server"),
 		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"interface":"/interface","address_pool":"/ip/pool|static-only"`),
 
 		"add_arp": {
 			Type:        schema.TypeBool,
@@ -110,13 +111,13 @@ func ResourceDhcpServer() *schema.Resource {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Description:  "The IP address of the relay this DHCP server.",
-			ValidateFunc: ValidationIpAddress,
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
 		},
 		"src_address": {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Description:  "The address which the DHCP client must send requests to in order to renew an IP address lease.",
-			ValidateFunc: ValidationIpAddress,
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
 		},
 		"use_framed_as_classless": {
 			Type:        schema.TypeBool,
@@ -137,8 +138,9 @@ func ResourceDhcpServer() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
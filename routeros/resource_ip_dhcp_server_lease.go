@@ -10,6 +10,7 @@ func ResourceDhcpServerLease() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/dhcp-server/lease"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"mac_address":"mac"`),
 
 		"active_address": {
 			Type:        schema.TypeString,
@@ -174,7 +175,7 @@ func ResourceDhcpServerLease() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -31,6 +31,9 @@ func ResourceDns() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/dns"),
 		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"cache_max_ttl":"duration","doh_timeout":"duration","query_server_timeout":"duration","query_total_timeout":"duration"`),
+
+		KeyResetOnDestroy: PropResetOnDestroy,
 
 		"allow_remote_requests": {
 			Type:        schema.TypeBool,
@@ -44,8 +47,7 @@ func ResourceDns() *schema.Resource {
 			Description: "Maximum time-to-live for cache records. In other words, cache records will expire " +
 				"unconditionally after cache-max-ttl time. Shorter TTL received from DNS servers are respected. " +
 				"*Default: 1w*",
-			ValidateFunc:     ValidationTime,
-			DiffSuppressFunc: TimeEquall,
+			ValidateFunc: ValidationTime,
 		},
 		"cache_size": {
 			Type:        schema.TypeInt,
@@ -71,11 +73,10 @@ func ResourceDns() *schema.Resource {
 			Description: "Specifies how many concurrent connections to the DoH server are allowed.",
 		},
 		"doh_timeout": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Computed:         true,
-			Description:      "Specifies how long to wait for query response from the DoH server.",
-			DiffSuppressFunc: TimeEquall,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies how long to wait for query response from the DoH server.",
 		},
 		"dynamic_servers": {
 			Type:        schema.TypeString,
@@ -107,8 +108,7 @@ func ResourceDns() *schema.Resource {
 			Computed: true,
 			Description: "Specifies how long to wait for query response from one server. " +
 				"Time can be specified in milliseconds. *Default: 2s*",
-			ValidateFunc:     ValidationTime,
-			DiffSuppressFunc: TimeEquall,
+			ValidateFunc: ValidationTime,
 		},
 		"query_total_timeout": {
 			Type:     schema.TypeString,
@@ -117,8 +117,7 @@ func ResourceDns() *schema.Resource {
 			Description: "Specifies how long to wait for query response in total. Note that this setting must be " +
 				"configured taking into account query_server_timeout and number of used DNS server. " +
 				"Time can be specified in milliseconds. *Default: 10s*",
-			ValidateFunc:     ValidationTime,
-			DiffSuppressFunc: TimeEquall,
+			ValidateFunc: ValidationTime,
 		},
 		"servers": {
 			Type:        schema.TypeString,
@@ -141,6 +140,24 @@ func ResourceDns() *schema.Resource {
 		},
 	}
 
+	// Factory defaults for the 'reset_on_destroy' mode, values in the Mikrotik notation!
+	resetDefaults := MikrotikItem{
+		"allow-remote-requests":       "no",
+		"cache-max-ttl":               "1w",
+		"cache-size":                  "2048",
+		"doh-max-concurrent-queries":  "50",
+		"doh-max-server-connections":  "5",
+		"doh-timeout":                 "5s",
+		"max-concurrent-queries":      "100",
+		"max-concurrent-tcp-sessions": "20",
+		"max-udp-packet-size":         "4096",
+		"query-server-timeout":        "2s",
+		"query-total-timeout":         "10s",
+		"servers":                     "",
+		"use-doh-server":              "",
+		"verify-doh-cert":             "no",
+	}
+
 	return &schema.Resource{
 		Description: "A MikroTik router with DNS feature enabled can be set as a DNS server for any DNS-compliant client.",
 
@@ -151,6 +168,10 @@ func ResourceDns() *schema.Resource {
 		// With existing serialization logic, the best way to avoid undefined DNS service state
 		// is to clear the main fields.
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if d.Get(KeyResetOnDestroy).(bool) {
+				return SystemResourceDelete(ctx, resSchema, resetDefaults, d, m)
+			}
+
 			// Values in the Mikrotik notation!
 			resetFileds := map[string]string{
 				"allow-remote-requests": "no",
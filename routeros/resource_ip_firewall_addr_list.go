@@ -2,7 +2,6 @@ package routeros
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"regexp"
 )
 
 /*
@@ -19,8 +18,6 @@ import (
 
 // ResourceIPFirewallAddrList https://wiki.mikrotik.com/wiki/Manual:IP/Firewall/Address_list
 func ResourceIPFirewallAddrList() *schema.Resource {
-	var reIPv4Range = regexp.MustCompile(`(\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3})\s*-\s*(\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3})`)
-
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/firewall/address-list"),
 		MetaId:           PropId(Id),
@@ -40,9 +37,8 @@ func ResourceIPFirewallAddrList() *schema.Resource {
 					return false
 				}
 
-				ips := reIPv4Range.FindStringSubmatch(new)
-				if len(ips) == 3 {
-					s, _ := IpRangeToCIDR(ips[1], ips[2])
+				if ip1, ip2, err := ParseIpRange(new); err == nil {
+					s, _ := IpRangeToCIDR(ip1, ip2)
 					return old == s
 				}
 
@@ -102,7 +98,7 @@ the address will be stored into the address list permanently.
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -28,6 +28,21 @@ func TestAccIPFirewallFilterTest_basic(t *testing.T) {
 							resource.TestCheckResourceAttr(testIPFirewallFilterAddress, "action", "accept"),
 						),
 					},
+					{
+						Config: testAccIPFirewallFilterPlaceBeforeConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckIPFirewallFilterExists(testIPFirewallFilterAddress),
+							resource.TestCheckResourceAttrPair(testIPFirewallFilterAddress, "place_before",
+								"routeros_firewall_filter.rule_first", "id"),
+						),
+					},
+					{
+						ResourceName:            testIPFirewallFilterAddress,
+						ImportState:             true,
+						ImportStateId:           "chain=forward,src_address=10.0.0.1,dst_address=10.0.1.1",
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"place_before"},
+					},
 				},
 			})
 
@@ -68,3 +83,27 @@ resource "routeros_firewall_filter" "rule" {
 
 `
 }
+
+func testAccIPFirewallFilterPlaceBeforeConfig() string {
+	return providerConfig + `
+
+resource "routeros_firewall_filter" "rule_first" {
+	action 		= "drop"
+	chain   	= "forward"
+	src_address = "10.0.0.2"
+	dst_address = "10.0.1.2"
+	dst_port 	= "443"
+	protocol 	= "tcp"
+}
+
+resource "routeros_firewall_filter" "rule" {
+	action 		 = "accept"
+	chain   	 = "forward"
+	src_address  = "10.0.0.1"
+	dst_address  = "10.0.1.1"
+	dst_port 	 = "443"
+	protocol 	 = "tcp"
+	place_before = routeros_firewall_filter.rule_first.id
+}
+`
+}
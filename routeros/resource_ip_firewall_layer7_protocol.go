@@ -0,0 +1,43 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+/*
+  {
+    ".id": "*1",
+    "name": "http",
+    "regexp": "^(get|post) .+ http/1\\.[01]"
+  }
+*/
+
+// ResourceIPFirewallLayer7Protocol https://help.mikrotik.com/docs/display/ROS/Layer7
+func ResourceIPFirewallLayer7Protocol() func() resource.Resource {
+	return NewFrameworkResource("ip_firewall_layer7_protocol", fwschema.Schema{
+		Description: "Layer7-protocol is a method of searching for patterns in ICMP/TCP/UDP streams.",
+		Attributes: map[string]fwschema.Attribute{
+			MetaResourcePath: FwPropResourcePath("/ip/firewall/layer7-protocol"),
+			MetaId:           FwPropId(Name),
+
+			"id": FwPropIdRo,
+			"comment": fwschema.StringAttribute{
+				Optional: true,
+			},
+			"name": fwschema.StringAttribute{
+				Required:    true,
+				Description: "Descriptive name of the Layer7 pattern used by the configuration in firewall rules.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"regexp": fwschema.StringAttribute{
+				Required:    true,
+				Description: "POSIX compliant regular expression is used to match a pattern.",
+			},
+		},
+	})
+}
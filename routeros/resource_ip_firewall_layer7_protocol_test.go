@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIPFirewallLayer7ProtocolAddress = "routeros_ip_firewall_layer7_protocol.test"
+
+func TestAccIPFirewallLayer7ProtocolTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				// The framework resource is served through the mux.
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIPFirewallLayer7ProtocolConfig("^(get|post) .+ http"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckIPFirewallLayer7ProtocolExists(testIPFirewallLayer7ProtocolAddress),
+							resource.TestCheckResourceAttr(testIPFirewallLayer7ProtocolAddress, "id", "test-http"),
+							resource.TestCheckResourceAttr(testIPFirewallLayer7ProtocolAddress, "regexp", "^(get|post) .+ http"),
+						),
+					},
+					{
+						Config: testAccIPFirewallLayer7ProtocolConfig("^get .+ http"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIPFirewallLayer7ProtocolAddress, "regexp", "^get .+ http"),
+						),
+					},
+					{
+						ResourceName:      testIPFirewallLayer7ProtocolAddress,
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckIPFirewallLayer7ProtocolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccIPFirewallLayer7ProtocolConfig(regexp string) string {
+	return fmt.Sprintf(`%v
+
+resource "routeros_ip_firewall_layer7_protocol" "test" {
+	name   = "test-http"
+	regexp = "%v"
+}
+`, providerConfig, regexp)
+}
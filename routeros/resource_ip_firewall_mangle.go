@@ -27,6 +27,9 @@ func ResourceIPFirewallMangle() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/firewall/mangle"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"connection_state":"list","dst_address":"ip","src_address":"ip"`),
+		MetaReferences: PropReferences(`"in_interface":"/interface","out_interface":"/interface","in_interface_list":"/interface/list",
+			"out_interface_list":"/interface/list","routing_mark":"/routing/table","new_routing_mark":"/routing/table"`),
 
 		"action": {
 			Type:        schema.TypeString,
@@ -127,9 +130,10 @@ func ResourceIPFirewallMangle() *schema.Resource {
 			ValidateFunc: validation.IntBetween(0, 63),
 		},
 		"dst_address": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Matches packets which destination is equal to specified IP or falls into specified IP range.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Matches packets which destination is equal to specified IP or falls into specified IP range.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix | IpRange | IpNegation),
 		},
 		"dst_address_list": {
 			Type:        schema.TypeString,
@@ -383,9 +387,10 @@ func ResourceIPFirewallMangle() *schema.Resource {
 			ValidateFunc: validation.IsIPAddress,
 		},
 		"src_address": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Matches packets which source is equal to specified IP or falls into a specified IP range.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Matches packets which source is equal to specified IP or falls into a specified IP range.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix | IpRange | IpNegation),
 		},
 		"src_address_list": {
 			Type:        schema.TypeString,
@@ -442,8 +447,9 @@ func ResourceIPFirewallMangle() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
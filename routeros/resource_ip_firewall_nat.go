@@ -29,6 +29,9 @@ func ResourceIPFirewallNat() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/firewall/nat"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"dst_address":"ip","src_address":"ip"`),
+		MetaReferences: PropReferences(`"in_interface":"/interface","out_interface":"/interface","in_interface_list":"/interface/list",
+			"out_interface_list":"/interface/list","routing_mark":"/routing/table"`),
 
 		"action": {
 			Type:        schema.TypeString,
@@ -113,9 +116,10 @@ func ResourceIPFirewallNat() *schema.Resource {
 			ValidateFunc: validation.IntBetween(0, 63),
 		},
 		"dst_address": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Matches packets which destination is equal to specified IP or falls into specified IP range.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Matches packets which destination is equal to specified IP or falls into specified IP range.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix | IpRange | IpNegation),
 		},
 		"dst_address_list": {
 			Type:        schema.TypeString,
@@ -326,9 +330,10 @@ func ResourceIPFirewallNat() *schema.Resource {
 				"new source IP address. Applicable if action=same",
 		},
 		"src_address": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Matches packets which source is equal to specified IP or falls into a specified IP range.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Matches packets which source is equal to specified IP or falls into a specified IP range.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix | IpRange | IpNegation),
 		},
 		"src_address_list": {
 			Type:        schema.TypeString,
@@ -387,8 +392,9 @@ func ResourceIPFirewallNat() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -0,0 +1,97 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "HTTPS": "false",
+    "address-pool": "pool-guests",
+    "addresses-per-mac": "2",
+    "disabled": "false",
+    "idle-timeout": "5m",
+    "interface": "bridge-guests",
+    "invalid": "false",
+    "keepalive-timeout": "none",
+    "login-timeout": "none",
+    "name": "guests",
+    "profile": "guests",
+    "proxy-status": "running"
+  }
+*/
+
+// ResourceIpHotspot https://help.mikrotik.com/docs/display/ROS/Hotspot+customisation
+func ResourceIpHotspot() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/hotspot"),
+		MetaId:           PropId(Name),
+		MetaReferences: PropReferences(`"address_pool":"/ip/pool|none","interface":"/interface",
+			"profile":"/ip/hotspot/profile"`),
+		MetaTransformSet: PropTransformSet(`"HTTPS": "https"`),
+
+		"address_pool": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Address space used to change the HotSpot client any IP address to a valid address: `none` or the name of the IP pool.",
+		},
+		"addresses_per_mac": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Number of IP addresses allowed to be bind with the MAC address: `2` or `unlimited`.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"https": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the HTTPS service is actually running on the interface.",
+		},
+		"idle_timeout": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Period of inactivity for unauthorized clients. When there is no traffic from this client " +
+				"(literally client computer should be switched off), once the timeout is reached, the user is dropped " +
+				"from the host list: `5m` or `none`.",
+		},
+		KeyInterface: PropInterfaceRw,
+		KeyInvalid:   PropInvalidRo,
+		"keepalive_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The exact value of the keepalive-timeout, that is applied to the user: `2m` or `none`.",
+		},
+		"login_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Period of time after which if a host hasn't been authorized itself with the system the host entry gets deleted: `none`.",
+		},
+		KeyName: PropName("HotSpot server's name or identifier."),
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "HotSpot server default HotSpot profile (`routeros_ip_hotspot_profile`).",
+		},
+		"proxy_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the HotSpot proxy: `running`.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
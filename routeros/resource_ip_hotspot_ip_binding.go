@@ -0,0 +1,86 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "address": "192.168.10.20",
+    "bypassed": "true",
+    "disabled": "false",
+    "mac-address": "00:11:22:33:44:55",
+    "server": "all",
+    "to-address": "192.168.10.20",
+    "type": "bypassed"
+  }
+*/
+
+// ResourceIpHotspotIpBinding https://help.mikrotik.com/docs/display/ROS/Hotspot+customisation
+func ResourceIpHotspotIpBinding() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/hotspot/ip-binding"),
+		MetaId:           PropId(Id),
+
+		"address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The original IP address of the client.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix),
+		},
+		"blocked": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The client is blocked.",
+		},
+		"bypassed": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The client is bypassed.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "MAC address of the client.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"server": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the HotSpot server: `all` or the server name.",
+		},
+		"to_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "IP address to translate the original client address to.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Type of the IP binding action: `regular` (one-to-one NAT), `bypassed` (the client is " +
+				"not required to login) or `blocked` (the client is not allowed to login).",
+			ValidateFunc: validation.StringInSlice([]string{"blocked", "bypassed", "regular"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,371 @@
+package routeros
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*2",
+    "default": "false",
+    "dns-name": "guests.example.com",
+    "hotspot-address": "192.168.10.1",
+    "html-directory": "flash/hotspot-guests",
+    "html-directory-override": "",
+    "http-cookie-lifetime": "3d",
+    "http-proxy": "0.0.0.0:0",
+    "install-hotspot-queue": "false",
+    "login-by": "cookie,http-chap,https",
+    "name": "guests",
+    "rate-limit": "",
+    "smtp-server": "0.0.0.0",
+    "split-user-domain": "false",
+    "ssl-certificate": "hotspot",
+    "use-radius": "false"
+  }
+*/
+
+// ResourceIpHotspotProfile https://help.mikrotik.com/docs/display/ROS/Hotspot+customisation
+func ResourceIpHotspotProfile() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/hotspot/profile"),
+		MetaId:           PropId(Name),
+		MetaNormalize: PropNormalize(`"http_cookie_lifetime":"duration","radius_interim_update":"duration",
+			"trial_uptime_limit":"duration","trial_uptime_reset":"duration"`),
+		MetaSecrets:    PropSecrets(`"mac_auth_password"`),
+		MetaSkipFields: PropSkipFields(`"html_directory_source","html_directory_source_hash"`),
+
+		"default": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The default HotSpot profile.",
+		},
+		"dns_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "DNS name of the HotSpot server (it appears as the location of the login page).",
+		},
+		"hotspot_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "IP address of HotSpot service.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
+		},
+		"html_directory": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Directory name in which HotSpot HTML pages are stored: `flash/hotspot`. The files of " +
+				"`html_directory_source` are uploaded into this directory.",
+		},
+		"html_directory_override": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Alternative path for hotspot html files. It should be used only if customized hotspot html files are stored on external storage.",
+		},
+		"html_directory_source": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "A local directory with the custom login pages. The files are uploaded into the " +
+				"`html_directory` via the `/file` API on create and whenever their contents change. Only text files " +
+				"(HTML, CSS, JS, ...) are supported by the `/file` API. This is a provider setting, it is not stored " +
+				"on the router.",
+			RequiredWith: []string{"html_directory"},
+		},
+		"html_directory_source_hash": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The hash of the files of `html_directory_source` last uploaded to the router.",
+		},
+		"http_cookie_lifetime": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "HTTP cookie validity time, the option is related to cookie HotSpot login method.",
+		},
+		"http_proxy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The address and the port of the proxy server for the HotSpot service: `0.0.0.0:0`.",
+		},
+		"https_redirect": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to redirect unauthenticated user to hotspot login page, if he is visiting a https:// url.",
+		},
+		"install_hotspot_queue": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Install a simple queue for the HotSpot clients.",
+		},
+		"login_by": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Used HotSpot authentication method.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"cookie", "http-chap", "http-pap", "https", "mac",
+					"mac-cookie", "trial"}, false),
+			},
+		},
+		"mac_auth_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The password of the MAC authentication.",
+			ValidateFunc: validation.StringInSlice([]string{"mac-as-username", "mac-as-username-and-password"}, false),
+		},
+		"mac_auth_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Used together with MAC authentication, field used to specify password for the users to be authenticated by their MAC addresses.",
+		},
+		KeyName: PropName("Name of the HotSpot profile."),
+		"nas_port_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "NAS-Port-Type value to be sent to RADIUS server: `wireless-802.11`, `ethernet`.",
+		},
+		"radius_accounting": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Send RADIUS server accounting information for each user, when yes is used.",
+		},
+		"radius_default_domain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Default domain to use for RADIUS requests.",
+		},
+		"radius_interim_update": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "How often to send accounting updates to the RADIUS server: `received` or the time interval.",
+		},
+		"radius_location_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The WISPr-Location-ID RADIUS attribute.",
+		},
+		"radius_location_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The WISPr-Location-Name RADIUS attribute.",
+		},
+		"radius_mac_format": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Controls how the MAC address of the client is encoded in the RADIUS requests: `XX:XX:XX:XX:XX:XX`.",
+		},
+		"rate_limit": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Rate limitation in the form of `rx-rate[/tx-rate]` for the HotSpot users.",
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"smtp_server": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "SMTP server address to be used to redirect HotSpot users SMTP requests.",
+		},
+		"split_user_domain": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Split username from domain name when the username is given in `user@domain` or in `domain\\user` format.",
+		},
+		"ssl_certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the SSL certificate on the router to to use only for HTTPS authentication.",
+		},
+		"trial_uptime_limit": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Used only with trial authentication method. Time value specifies, how long trial user identified by MAC address can use access to public networks without HotSpot authentication.",
+		},
+		"trial_uptime_reset": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Used only with trial authentication method.",
+		},
+		"trial_user_profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies hotspot user profile for trial users.",
+		},
+		"use_radius": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Use RADIUS to authenticate HotSpot users.",
+		},
+	}
+
+	resCreate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if diags := ResourceCreate(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
+
+		return hotspotHtmlDirectoryUpload(ctx, d, m.(Client))
+	}
+
+	resUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if diags := ResourceUpdate(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
+
+		if !d.HasChanges("html_directory", "html_directory_source", "html_directory_source_hash") {
+			return nil
+		}
+
+		return hotspotHtmlDirectoryUpload(ctx, d, m.(Client))
+	}
+
+	return &schema.Resource{
+		CreateContext: resCreate,
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: resUpdate,
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if err := DefaultCustomizeDiff(resSchema)(ctx, d, m); err != nil {
+				return err
+			}
+
+			// Local changes of the login pages are planned as an update of the profile.
+			src := d.Get("html_directory_source").(string)
+			if src == "" {
+				return nil
+			}
+
+			hash, err := hotspotHtmlDirectoryHash(src)
+			if err != nil {
+				return err
+			}
+
+			if hash != d.Get("html_directory_source_hash").(string) {
+				return d.SetNew("html_directory_source_hash", hash)
+			}
+
+			return nil
+		},
+
+		Schema: resSchema,
+	}
+}
+
+// hotspotHtmlDirectoryFiles Returns the relative paths (slash-separated) of the files in the local directory.
+func hotspotHtmlDirectoryFiles(src string) ([]string, error) {
+	var res []string
+
+	err := filepath.WalkDir(src, func(p string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !e.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+
+		res = append(res, filepath.ToSlash(rel))
+		return nil
+	})
+
+	sort.Strings(res)
+	return res, err
+}
+
+// hotspotHtmlDirectoryHash The hash of the names and the contents of the files in the local directory.
+func hotspotHtmlDirectoryHash(src string) (string, error) {
+	files, err := hotspotHtmlDirectoryFiles(src)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, name := range files {
+		b, err := os.ReadFile(filepath.Join(src, filepath.FromSlash(name)))
+		if err != nil {
+			return "", err
+		}
+
+		// name \0 size \0 contents
+		fmt.Fprintf(h, "%v\x00%d\x00", name, len(b))
+		h.Write(b)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hotspotHtmlDirectoryUpload Uploading the files of the 'html_directory_source' into the 'html_directory'
+// on the router. The existing files are overwritten, the files that are not in the source are left untouched.
+func hotspotHtmlDirectoryUpload(ctx context.Context, d *schema.ResourceData, c Client) diag.Diagnostics {
+	src := d.Get("html_directory_source").(string)
+	if src == "" {
+		return nil
+	}
+
+	dst := d.Get("html_directory").(string)
+
+	files, err := hotspotHtmlDirectoryFiles(src)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, name := range files {
+		b, err := os.ReadFile(filepath.Join(src, filepath.FromSlash(name)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !utf8.Valid(b) {
+			return diag.Errorf("the file '%v' of the 'html_directory_source' is not a text file, only the text "+
+				"files can be uploaded via the /file API", name)
+		}
+
+		item := MikrotikItem{"name": path.Join(dst, name), "contents": string(b)}
+		ColorizedDebug(ctx, fmt.Sprintf("uploading the hotspot file '%v'", item["name"]))
+
+		res, err := ReadItems(&ItemId{Name, item["name"]}, "/file", c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(*res) > 0 {
+			_, err = UpdateItem(&ItemId{Id, (*res)[0].GetID(Id)}, "/file", MikrotikItem{"contents": item["contents"]}, c)
+		} else {
+			_, err = CreateItem(item, "/file", c)
+		}
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("uploading the file '%v': %w", item["name"], err))
+		}
+	}
+
+	hash, err := hotspotHtmlDirectoryHash(src)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("html_directory_source_hash", hash))
+}
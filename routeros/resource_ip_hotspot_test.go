@@ -0,0 +1,173 @@
+package routeros
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testIpHotspotAddress               = "routeros_ip_hotspot.test"
+	testIpHotspotProfileAddress        = "routeros_ip_hotspot_profile.test"
+	testIpHotspotUserProfileAddress    = "routeros_ip_hotspot_user_profile.test"
+	testIpHotspotUserAddress           = "routeros_ip_hotspot_user.test"
+	testIpHotspotWalledGardenAddress   = "routeros_ip_hotspot_walled_garden.test"
+	testIpHotspotWalledGardenIpAddress = "routeros_ip_hotspot_walled_garden_ip.test"
+	testIpHotspotIpBindingAddress      = "routeros_ip_hotspot_ip_binding.test"
+)
+
+func TestAccIpHotspotTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/ip/hotspot/ip-binding", "routeros_ip_hotspot_ip_binding"),
+					testCheckResourceDestroy("/ip/hotspot/walled-garden/ip", "routeros_ip_hotspot_walled_garden_ip"),
+					testCheckResourceDestroy("/ip/hotspot/walled-garden", "routeros_ip_hotspot_walled_garden"),
+					testCheckResourceDestroy("/ip/hotspot/user", "routeros_ip_hotspot_user"),
+					testCheckResourceDestroy("/ip/hotspot/user/profile", "routeros_ip_hotspot_user_profile"),
+					testCheckResourceDestroy("/ip/hotspot", "routeros_ip_hotspot"),
+					testCheckResourceDestroy("/ip/hotspot/profile", "routeros_ip_hotspot_profile"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIpHotspotConfig("1d"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckIpHotspotExists(testIpHotspotAddress),
+							testAccCheckIpHotspotExists(testIpHotspotProfileAddress),
+							testAccCheckIpHotspotExists(testIpHotspotUserProfileAddress),
+							testAccCheckIpHotspotExists(testIpHotspotUserAddress),
+							testAccCheckIpHotspotExists(testIpHotspotWalledGardenAddress),
+							testAccCheckIpHotspotExists(testIpHotspotWalledGardenIpAddress),
+							testAccCheckIpHotspotExists(testIpHotspotIpBindingAddress),
+							resource.TestCheckResourceAttr(testIpHotspotAddress, "profile", "test_hotspot_profile"),
+							resource.TestCheckResourceAttr(testIpHotspotProfileAddress, "login_by.#", "2"),
+							resource.TestCheckResourceAttr(testIpHotspotUserAddress, "limit_uptime", "1d"),
+							resource.TestCheckResourceAttr(testIpHotspotIpBindingAddress, "type", "bypassed"),
+						),
+					},
+					{
+						Config: testAccIpHotspotConfig("12h"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIpHotspotUserAddress, "limit_uptime", "12h"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckIpHotspotExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccIpHotspotConfig(limitUptime string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_ip_hotspot_profile" "test" {
+	name            = "test_hotspot_profile"
+	hotspot_address = "192.168.77.1"
+	login_by        = ["http-chap", "cookie"]
+}
+
+resource "routeros_ip_hotspot" "test" {
+	name      = "test_hotspot"
+	interface = "ether1"
+	profile   = routeros_ip_hotspot_profile.test.name
+	disabled  = true
+}
+
+resource "routeros_ip_hotspot_user_profile" "test" {
+	name         = "test_hotspot_user_profile"
+	rate_limit   = "2M/10M"
+	shared_users = "2"
+}
+
+resource "routeros_ip_hotspot_user" "test" {
+	name         = "test_hotspot_user"
+	password     = "test_hotspot_password"
+	profile      = routeros_ip_hotspot_user_profile.test.name
+	limit_uptime = "%v"
+}
+
+resource "routeros_ip_hotspot_walled_garden" "test" {
+	dst_host = "*.example.com"
+	action   = "allow"
+}
+
+resource "routeros_ip_hotspot_walled_garden_ip" "test" {
+	dst_address = "192.0.2.10"
+	protocol    = "udp"
+	dst_port    = "53"
+}
+
+resource "routeros_ip_hotspot_ip_binding" "test" {
+	mac_address = "00:11:22:33:44:55"
+	type        = "bypassed"
+}
+`, limitUptime)
+}
+
+func Test_hotspotHtmlDirectoryHash(t *testing.T) {
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "css"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	write := func(name, contents string) {
+		if err := os.WriteFile(filepath.Join(src, filepath.FromSlash(name)), []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("login.html", "<html>login</html>")
+	write("css/style.css", "body {}")
+
+	files, err := hotspotHtmlDirectoryFiles(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"css/style.css", "login.html"}; fmt.Sprint(files) != fmt.Sprint(expected) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, files)
+	}
+
+	h1, err := hotspotHtmlDirectoryHash(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h2, _ := hotspotHtmlDirectoryHash(src)
+	if h1 != h2 {
+		t.Fatalf("bad: the hash of the same files differs: %v != %v", h1, h2)
+	}
+
+	write("login.html", "<html>welcome</html>")
+	if h3, _ := hotspotHtmlDirectoryHash(src); h3 == h1 {
+		t.Fatalf("bad: the hash has not changed after the file was changed: %v", h3)
+	}
+}
@@ -0,0 +1,139 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "bytes-in": "0",
+    "bytes-out": "0",
+    "disabled": "false",
+    "dynamic": "false",
+    "limit-uptime": "1d",
+    "name": "guest",
+    "packets-in": "0",
+    "packets-out": "0",
+    "password": "guest-password",
+    "profile": "guests",
+    "server": "all",
+    "uptime": "0s"
+  }
+*/
+
+// ResourceIpHotspotUser https://help.mikrotik.com/docs/display/ROS/Hotspot+customisation
+func ResourceIpHotspotUser() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/hotspot/user"),
+		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"limit_uptime":"duration"`),
+		MetaReferences:   PropReferences(`"profile":"/ip/hotspot/user/profile"`),
+		MetaSecrets:      PropSecrets(`"password"`),
+
+		"address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "IP address, when specified client will get the address from the HotSpot one-to-one NAT translations.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
+		},
+		"bytes_in": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total amount of bytes received from the user.",
+		},
+		"bytes_out": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total amount of bytes sent to the user.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		KeyDynamic:  PropDynamicRo,
+		"email": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "HotSpot client's e-mail, informational value for the HotSpot user.",
+		},
+		"limit_bytes_in": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximal amount of bytes that can be received from the user. User is disconnected from HotSpot after the limit is reached.",
+		},
+		"limit_bytes_out": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximal amount of bytes that can be transmitted to the user. User is disconnected from HotSpot after the limit is reached.",
+		},
+		"limit_bytes_total": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximal amount of bytes that can be transmitted to and received from the user. User is disconnected from HotSpot after the limit is reached.",
+		},
+		"limit_uptime": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Uptime limit for the HotSpot client, user is disconnected from HotSpot as soon as uptime is reached.",
+		},
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Client is allowed to login only from the specified MAC address.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		KeyName: PropName("HotSpot login page username, when MAC address authentication is used name is configured as client's MAC address."),
+		"packets_in": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total amount of packets received from the user.",
+		},
+		"packets_out": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total amount of packets sent to the user.",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "User password.",
+		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "User profile (`routeros_ip_hotspot_user_profile`) configured in the user profiles.",
+		},
+		"routes": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Routes added to HotSpot gateway when client is connected. The route format is: `dst-address gateway metric`.",
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"server": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "HotSpot server's name to which user is allowed login: `all` or the server name.",
+		},
+		"uptime": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The total time the user has been logged in.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
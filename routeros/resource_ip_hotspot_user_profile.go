@@ -0,0 +1,188 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*2",
+    "add-mac-cookie": "true",
+    "address-list": "hotspot-guests",
+    "address-pool": "none",
+    "default": "false",
+    "idle-timeout": "none",
+    "insert-queue-before": "first",
+    "keepalive-timeout": "2m",
+    "mac-cookie-timeout": "3d",
+    "name": "guests",
+    "open-status-page": "always",
+    "parent-queue": "none",
+    "queue-type": "default-small",
+    "rate-limit": "2M/10M",
+    "shared-users": "1",
+    "status-autorefresh": "1m",
+    "transparent-proxy": "false"
+  }
+*/
+
+// ResourceIpHotspotUserProfile https://help.mikrotik.com/docs/display/ROS/Hotspot+customisation
+func ResourceIpHotspotUserProfile() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/hotspot/user/profile"),
+		MetaId:           PropId(Name),
+		MetaNormalize: PropNormalize(`"advertise_interval":"duration","mac_cookie_timeout":"duration",
+			"session_timeout":"duration","status_autorefresh":"duration"`),
+
+		"add_mac_cookie": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Allows to add mac cookie for users.",
+		},
+		"address_list": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the address list in which users IP address will be added.",
+		},
+		"address_pool": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The IP pool name which the users will be given IP addresses from: `none` or the name of the IP pool.",
+		},
+		"advertise": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Enables forced advertisement popups.",
+		},
+		"advertise_interval": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Set of intervals between advertisement popups: `30m,10m`.",
+		},
+		"advertise_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "How long to wait for advertisement to be shown, before blocking network access with walled-garden: `1m` or `immediately`.",
+		},
+		"advertise_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "List of URLs that is show for advertisement popups.",
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The default user profile.",
+		},
+		"idle_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Maximal period of inactivity for authorized clients: `5m` or `none`.",
+		},
+		"incoming_filter": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the firewall chain applied to incoming packets from the users of this profile.",
+		},
+		"incoming_packet_mark": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Packet mark put on incoming packets from every user of this profile.",
+		},
+		"insert_queue_before": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The position of the dynamic queue of the user: `bottom`, `first` or the name of the queue.",
+		},
+		"keepalive_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Keepalive timeout for authorized clients: `2m` or `none`.",
+		},
+		"mac_cookie_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Selects mac-cookie timeout from last login or logout.",
+		},
+		KeyName: PropName("Name of the user profile."),
+		"on_login": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Script name to be executed, when the user logs in to the HotSpot from the particular profile.",
+		},
+		"on_logout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Script name to be executed, when the user logs out from the HotSpot.",
+		},
+		"open_status_page": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Option to show status page for user authenticated with mac login method.",
+			ValidateFunc: validation.StringInSlice([]string{"always", "http-login"}, false),
+		},
+		"outgoing_filter": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the firewall chain applied to outgoing packets to the users of this profile.",
+		},
+		"outgoing_packet_mark": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Packet mark put on outgoing packets to every user of this profile.",
+		},
+		"parent_queue": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The parent queue of the dynamic queue of the user: `none` or the name of the queue.",
+		},
+		"queue_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The queue type of the dynamic queue of the user.",
+		},
+		"rate_limit": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Simple dynamic queue is created for user, once it logs in to the HotSpot. Rate-limitation " +
+				"is configured in the following form `rx-rate[/tx-rate] [rx-burst-rate[/tx-burst-rate] " +
+				"[rx-burst-threshold[/tx-burst-threshold] [rx-burst-time[/tx-burst-time] [priority] " +
+				"[rx-rate-min[/tx-rate-min]]]]`.",
+		},
+		"session_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Allowed session time for client. After this time, the user is logged out unconditionally.",
+		},
+		"shared_users": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Allowed number of simultaneously logged in users with the same HotSpot username: `1` or `unlimited`.",
+		},
+		"status_autorefresh": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "HotSpot servlet status page autorefresh interval.",
+		},
+		"transparent_proxy": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Use transparent HTTP proxy for the authorized users of this profile.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
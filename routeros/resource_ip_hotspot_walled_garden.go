@@ -0,0 +1,93 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "action": "allow",
+    "disabled": "false",
+    "dst-host": "*.example.com",
+    "dst-port": "443",
+    "dynamic": "false",
+    "hits": "0",
+    "server": "guests"
+  }
+*/
+
+// ResourceIpHotspotWalledGarden https://help.mikrotik.com/docs/display/ROS/Hotspot+customisation
+func ResourceIpHotspotWalledGarden() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/hotspot/walled-garden"),
+		MetaId:           PropId(Id),
+
+		"action": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "allow",
+			Description:  "Action to perform, when packet matches the rule.",
+			ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"dst_address": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Destination IP address of the request.",
+		},
+		"dst_host": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Domain name of the destination web server, wildcards are allowed: `*.example.com`.",
+		},
+		"dst_port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The TCP port a client has send the request to.",
+		},
+		KeyDynamic: PropDynamicRo,
+		"hits": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of requests matched by the rule.",
+		},
+		"method": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "HTTP method of the request.",
+		},
+		"path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The path of the request, wildcards are allowed.",
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"server": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the HotSpot server, rule is applied to.",
+		},
+		"src_address": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Source address of the user, usually IP address of a HotSpot client.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,97 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "action": "accept",
+    "disabled": "false",
+    "dst-address": "192.0.2.10",
+    "dst-port": "53",
+    "dynamic": "false",
+    "invalid": "false",
+    "protocol": "udp",
+    "server": "guests"
+  }
+*/
+
+// ResourceIpHotspotWalledGardenIp https://help.mikrotik.com/docs/display/ROS/Hotspot+customisation
+func ResourceIpHotspotWalledGardenIp() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/hotspot/walled-garden/ip"),
+		MetaId:           PropId(Id),
+
+		"action": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "accept",
+			Description:  "Action to perform, when packet matches the rule.",
+			ValidateFunc: validation.StringInSlice([]string{"accept", "drop", "reject"}, false),
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"dst_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Destination IP address, IP address of the WEB-server.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix | IpNegation),
+		},
+		"dst_address_list": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Destination IP address list.",
+		},
+		"dst_host": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Domain name of the destination web-server, the addresses are resolved by the router.",
+		},
+		"dst_port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The TCP port a client has send the request to.",
+		},
+		KeyDynamic:     PropDynamicRo,
+		KeyInvalid:     PropInvalidRo,
+		KeyPlaceBefore: PropPlaceBefore,
+		"protocol": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "IP protocol name or number: `tcp`, `udp`, `6`.",
+		},
+		"server": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the HotSpot server, rule is applied to.",
+		},
+		"src_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Source IP address of the user, usually IP address of a HotSpot client.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress | IpPrefix | IpNegation),
+		},
+		"src_address_list": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Source IP address list.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,195 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "auth-method": "pre-shared-key",
+    "disabled": "false",
+    "dynamic": "false",
+    "generate-policy": "no",
+    "match-by": "remote-id",
+    "mode-config": "none",
+    "my-id": "auto",
+    "notrack-chain": "",
+    "peer": "office-b",
+    "policy-template-group": "default",
+    "remote-id": "auto",
+    "secret": "********"
+  }
+*/
+
+// ResourceIPIpsecIdentity https://help.mikrotik.com/docs/display/ROS/IPsec#IPsec-Identities
+func ResourceIPIpsecIdentity() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/ipsec/identity"),
+		MetaId:           PropId(Id),
+		MetaReferences: PropReferences(`"peer":"/ip/ipsec/peer","policy_template_group":"/ip/ipsec/policy/group",
+			"certificate":"/certificate","remote_certificate":"/certificate"`),
+		MetaSecrets: PropSecrets(`"password","secret"`),
+
+		"auth_method": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Authentication method: `digital-signature` - authenticate using a pair of RSA certificates; " +
+				"`eap` - IKEv2 EAP authentication for initiator (peer with a netmask of /32). Must be used together " +
+				"with eap-methods; `eap-radius` - IKEv2 EAP RADIUS passthrough authentication for the responder " +
+				"(RFC 3579). A server certificate in this case is required; `pre-shared-key` - authenticate by a " +
+				"password (pre-shared secret) string shared between the peers (not recommended since an offline " +
+				"attack on the pre-shared key is possible); `rsa-key` - authenticate using an RSA key imported in " +
+				"keys menu. Only supported in IKEv1; `pre-shared-key-xauth` - mutual PSK authentication + xauth " +
+				"username/password; `rsa-signature-hybrid` - responder certificate authentication with initiator " +
+				"Xauth.",
+			ValidateFunc: validation.StringInSlice([]string{"digital-signature", "eap", "eap-radius",
+				"pre-shared-key", "pre-shared-key-xauth", "rsa-key", "rsa-signature-hybrid"}, false),
+		},
+		"certificate": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Name of a certificate listed in certificate table (signing packets; the certificate must " +
+				"have the private key). Applicable if digital signature authentication method " +
+				"(auth-method=digital-signature) or EAP (auth-method=eap) is used.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		KeyDynamic:  PropDynamicRo,
+		"eap_methods": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "All EAP methods requires whole certificate chain including intermediate and root CA " +
+				"certificates to be present in System/Certificates menu. Also, the username and password (if " +
+				"required by the authentication server) must be specified.",
+			ValidateDiagFunc: ValidationMultiValInSlice([]string{"eap-mschapv2", "eap-peap", "eap-tls", "eap-ttls"},
+				false, false),
+		},
+		"generate_policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Allow this peer to establish SA for non-existing policies. Such policies are created " +
+				"dynamically for the lifetime of SA. Automatic policies allows, for example, to create IPsec " +
+				"secured L2TP tunnels, or any other setup where remote peer's IP address is not known at the " +
+				"configuration time. `no` - do not generate policies; `port-override` - generate policies and " +
+				"force policy to use any port (old behavior); `port-strict` - use ports from peer's proposal, " +
+				"which should match peer's policy.",
+			ValidateFunc: validation.StringInSlice([]string{"no", "port-override", "port-strict"}, false),
+		},
+		"key": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Name of the private key from keys menu. Applicable if RSA key authentication method " +
+				"(auth-method=rsa-key) is used.",
+		},
+		"match_by": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Defines the logic used for peer's identity validation. `remote-id` - will verify the " +
+				"peer's ID according to remote-id setting; `certificate` will verify the peer's certificate with " +
+				"what is specified under remote-certificate setting.",
+			ValidateFunc: validation.StringInSlice([]string{"remote-id", "certificate"}, false),
+		},
+		"mode_config": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Name of the configuration parameters from mode-config menu. When parameter is set " +
+				"mode-config is enabled, `none` disables it.",
+		},
+		"my_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "On initiator, this controls what ID_i is sent to the responder. On responder, this " +
+				"controls what ID_r is sent to the initiator. In IKEv2, responder also expects this ID in received " +
+				"ID_r from initiator. `auto` - tries to use correct ID automatically: IP for pre-shared key, SAN " +
+				"(DN if not present) for certificate based connections; `address` - IP address is used as ID; " +
+				"`dn` - the binary Distinguished Encoding Rules (DER) encoding of an ASN.1 X.500 Distinguished " +
+				"Name; `fqdn` - fully qualified domain name; `key-id` - use the specified key ID for the identity; " +
+				"`user-fqdn` - specifies a fully-qualified username string, for example, `user@domain.com`.",
+		},
+		"notrack_chain": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Adds IP/Firewall/Raw rules matching IPsec policy to a specified chain. Use together " +
+				"with generate-policy.",
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			Description: "XAuth or EAP password. Applicable if pre-shared key with XAuth authentication method " +
+				"(auth-method=pre-shared-key-xauth) or EAP (auth-method=eap) is used.",
+		},
+		"peer": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the peer on which the identity applies.",
+		},
+		"policy_template_group": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "default",
+			Description: "If generate-policy is enabled, traffic selectors are checked against templates from the " +
+				"same group. If none of the templates match, Phase2 SA will not be established.",
+		},
+		"remote_certificate": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Name of a certificate (listed in certificate table) for authenticating the remote side " +
+				"(validating packets; no private key required). If a remote-certificate is not specified then the " +
+				"received certificate from a remote peer is used and checked against CA in the certificate menu. " +
+				"Applicable if digital signature authentication method (auth-method=digital-signature) is used.",
+		},
+		"remote_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "This parameter controls what ID value to expect from the remote peer. Note that all types " +
+				"except for ignore will verify remote peer's ID with a received certificate. In case when the peer " +
+				"sends the certificate name as its ID, it is checked against the certificate, else the ID is " +
+				"checked against Subject Alt. Name.",
+		},
+		"remote_key": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Name of the public key from keys menu. Applicable if RSA key authentication method " +
+				"(auth-method=rsa-key) is used.",
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"secret": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			Description: "Secret string. If it starts with '0x', it is parsed as a hexadecimal value. Applicable if " +
+				"pre-shared key authentication method (auth-method=pre-shared-key and auth-method=pre-shared-key-xauth) " +
+				"is used.",
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "XAuth or EAP username. Applicable if pre-shared key with XAuth authentication method " +
+				"(auth-method=pre-shared-key-xauth) or EAP (auth-method=eap) is used.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
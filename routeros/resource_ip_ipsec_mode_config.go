@@ -0,0 +1,125 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*2",
+    "address-pool": "ipsec-pool",
+    "address-prefix-length": "32",
+    "name": "road-warrior",
+    "responder": "true",
+    "split-include": "10.0.0.0/24",
+    "static-dns": "",
+    "system-dns": "true",
+    "use-responder-dns": "exclusively"
+  }
+*/
+
+// ResourceIPIpsecModeConfig https://help.mikrotik.com/docs/display/ROS/IPsec#IPsec-Modeconfigs
+func ResourceIPIpsecModeConfig() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/ipsec/mode-config"),
+		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"address_pool":"/ip/pool|none"`),
+
+		"address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Single IP address for the initiator instead of specifying a whole address pool.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
+		},
+		"address_pool": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Name of the address pool from which the responder will try to assign address.",
+			ConflictsWith: []string{"address"},
+		},
+		"address_prefix_length": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Prefix length (netmask) of the assigned address from the pool.",
+			ValidateFunc: validation.IntBetween(1, 32),
+		},
+		KeyComment: PropCommentRw,
+		"connection_mark": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Firewall connection mark.",
+		},
+		KeyName: PropName("Mode config name, it is referenced by the identities."),
+		"responder": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies whether the configuration will work as an initiator (client) or responder (server).",
+		},
+		"split_dns": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Description: "List of DNS names that will be resolved using a system-dns=yes or static-dns= setting. " +
+				"Applies only to the responder.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"split_include": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Description: "List of subnets in CIDR format, which to tunnel. Subnets will be sent to the peer using " +
+				"the CISCO UNITY extension, a remote peer will create specific dynamic policies.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: ValidationIp(IpV4 | IpPrefix),
+			},
+		},
+		"src_address_list": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Specifying an address list will generate dynamic source NAT rules. This parameter is only " +
+				"available with responder=no.",
+		},
+		"static_dns": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Description: "Manually specified DNS server's IP address to be sent to the other peer. Applies only " +
+				"to the responder.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: ValidationIp(IpV4 | IpAddress),
+			},
+		},
+		"system_dns": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+			Description: "When this option is enabled DNS addresses will be taken from `/ip dns`. Applies only " +
+				"to the responder.",
+		},
+		"use_responder_dns": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Whether to use the DNS servers received from the responder. Applies only to the initiator.",
+			ValidateFunc: validation.StringInSlice([]string{"exclusively", "no", "yes"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
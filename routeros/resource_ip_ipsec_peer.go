@@ -0,0 +1,110 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "address": "203.0.113.10/32",
+    "disabled": "false",
+    "dynamic": "false",
+    "exchange-mode": "ike2",
+    "local-address": "198.51.100.1",
+    "name": "office-b",
+    "passive": "false",
+    "profile": "s2s",
+    "responder": "false",
+    "send-initial-contact": "true"
+  }
+*/
+
+// ResourceIPIpsecPeer https://help.mikrotik.com/docs/display/ROS/IPsec#IPsec-Peers
+func ResourceIPIpsecPeer() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/ipsec/peer"),
+		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"profile":"/ip/ipsec/profile"`),
+		MetaNormalize:    PropNormalize(`"address":"ip","local_address":"ip"`),
+
+		"address": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "If the remote peer's address matches this prefix, then the peer configuration is used in " +
+				"authentication and establishment of Phase 1. If several peer's addresses match several " +
+				"configuration entries, the most specific one (i.e. the one with the largest netmask) will be used.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix),
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		KeyDynamic:  PropDynamicRo,
+		"exchange_mode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Different ISAKMP phase 1 exchange modes according to RFC 2408. The `main` mode relaxes " +
+				"rfc2409 section 5.4, to allow pre-shared-key authentication in the main mode. `ike2` mode enables " +
+				"Ikev2 RFC 7296. Parameters that are ignored by IKEv2 proposal-check, compatibility-options, " +
+				"lifebytes, dpd-maximum-failures, nat-traversal.",
+			ValidateFunc: validation.StringInSlice([]string{"aggressive", "base", "main", "ike2"}, false),
+		},
+		"local_address": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Routers local address on which Phase 1 should be bounded to. If not set, the address of " +
+				"the interface the packets are sent from is used.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress),
+		},
+		KeyName: PropName("Peer name, it is referenced by the identities and the policies."),
+		"passive": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+			Description: "When a passive mode is enabled will wait for a remote peer to initiate an IKE connection. " +
+				"The enabled passive mode also indicates that the peer is xauth responder, and disabled passive " +
+				"mode - xauth initiator.",
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "Communication port used (when a router is an initiator) to connect to remote peer in cases " +
+				"if remote peer uses the non-default port.",
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "default",
+			Description: "Name of the profile template that will be used during IKE negotiation.",
+		},
+		"responder": {
+			Type:     schema.TypeBool,
+			Computed: true,
+			Description: "Whether this peer will act as a responder only (listen to incoming requests) and not " +
+				"initiate a connection.",
+		},
+		"send_initial_contact": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+			Description: "Specifies whether to send `initial contact` IKE packet or wait for remote side, this " +
+				"packet should trigger the removal of old peer SAs for current source address.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
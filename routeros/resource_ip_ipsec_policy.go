@@ -0,0 +1,177 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*2",
+    "action": "encrypt",
+    "active": "true",
+    "disabled": "false",
+    "dst-address": "10.2.0.0/16",
+    "dst-port": "any",
+    "dynamic": "false",
+    "invalid": "false",
+    "ipsec-protocols": "esp",
+    "level": "require",
+    "peer": "office-b",
+    "ph2-count": "1",
+    "ph2-state": "established",
+    "proposal": "s2s",
+    "protocol": "all",
+    "sa-dst-address": "203.0.113.10",
+    "sa-src-address": "198.51.100.1",
+    "src-address": "10.1.0.0/16",
+    "src-port": "any",
+    "template": "false",
+    "tunnel": "true"
+  }
+*/
+
+// ResourceIPIpsecPolicy https://help.mikrotik.com/docs/display/ROS/IPsec#IPsec-Policies
+func ResourceIPIpsecPolicy() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/ipsec/policy"),
+		MetaId:           PropId(Id),
+		MetaReferences: PropReferences(`"peer":"/ip/ipsec/peer","proposal":"/ip/ipsec/proposal",
+			"group":"/ip/ipsec/policy/group"`),
+		MetaNormalize: PropNormalize(`"dst_address":"ip","src_address":"ip"`),
+
+		"action": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Specifies what to do with the packet matched by the policy. `none` - pass the packet " +
+				"unchanged; `discard` - drop the packet; `encrypt` - apply transformations specified in this " +
+				"policy and it's SA.",
+			ValidateFunc: validation.StringInSlice([]string{"discard", "encrypt", "none"}, false),
+		},
+		"active": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the policy is used by the IPsec SAs.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"dst_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Destination address to be matched in packets. Applicable when tunnel mode or template is used.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix),
+		},
+		"dst_port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Destination port to be matched in packets. If set to `any` all ports will be matched.",
+		},
+		KeyDynamic: PropDynamicRo,
+		"group": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "default",
+			Description: "Name of the policy group to which this template is assigned. Applicable only when the " +
+				"policy is a template.",
+		},
+		KeyInvalid: PropInvalidRo,
+		"ipsec_protocols": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Specifies what combination of Authentication Header and Encapsulating Security Payload " +
+				"protocols you want to apply to matched traffic.",
+			ValidateDiagFunc: ValidationMultiValInSlice([]string{"ah", "esp"}, false, false),
+		},
+		"level": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Specifies what to do if some of the SAs for this policy cannot be found: `use` - skip " +
+				"this transform, do not drop the packet, and do not acquire SA from IKE daemon; `require` - drop " +
+				"the packet and acquire SA; `unique` - drop the packet and acquire a unique SA that is only used " +
+				"with this particular policy.",
+			ValidateFunc: validation.StringInSlice([]string{"require", "unique", "use"}, false),
+		},
+		"peer": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the peer on which the policy applies.",
+		},
+		"ph2_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of active phase 2 sessions associated with the policy.",
+		},
+		"ph2_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Indication of the progress of key establishing.",
+		},
+		"proposal": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "default",
+			Description: "Name of the proposal template that will be sent by IKE daemon to establish SAs for this policy.",
+		},
+		"protocol": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "IP packet protocol to match: `all` or the name or the number of the protocol.",
+		},
+		"sa_dst_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SA destination IP/IPv6 address (remote peer).",
+		},
+		"sa_src_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SA source IP/IPv6 address (local peer).",
+		},
+		"src_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Source address to be matched in packets. Applicable when tunnel mode or template is used.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix),
+		},
+		"src_port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Source port to be matched in packets. If set to `any` all ports will be matched.",
+		},
+		"template": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+			Description: "Creates a template and assigns it to a specified policy group. Following parameters are " +
+				"used by template: group, src-address, dst-address, protocol, proposal.",
+		},
+		"tunnel": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies whether to use a tunnel mode.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
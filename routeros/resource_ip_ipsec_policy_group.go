@@ -0,0 +1,44 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*2",
+    "comment": "Site-to-site",
+    "default": "false",
+    "name": "s2s"
+  }
+*/
+
+// ResourceIPIpsecPolicyGroup https://help.mikrotik.com/docs/display/ROS/IPsec#IPsec-Groups
+func ResourceIPIpsecPolicyGroup() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/ipsec/policy/group"),
+		MetaId:           PropId(Name),
+
+		KeyComment: PropCommentRw,
+		"default": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether this is a default item.",
+		},
+		KeyName: PropName("Name of the policy template group, it is referenced by the identities and the policy templates."),
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
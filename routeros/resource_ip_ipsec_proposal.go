@@ -0,0 +1,91 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "auth-algorithms": "sha1",
+    "default": "true",
+    "disabled": "false",
+    "enc-algorithms": "aes-256-cbc,aes-192-cbc,aes-128-cbc",
+    "lifetime": "30m",
+    "name": "default",
+    "pfs-group": "modp1024"
+  }
+*/
+
+// ResourceIPIpsecProposal https://help.mikrotik.com/docs/display/ROS/IPsec#IPsec-Proposals
+func ResourceIPIpsecProposal() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/ipsec/proposal"),
+		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"lifetime":"duration"`),
+
+		"auth_algorithms": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Allowed algorithms for authorization. SHA (Secure Hash Algorithm) is stronger but slower.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"md5", "null", "sha1", "sha256", "sha512"}, false),
+			},
+		},
+		KeyComment: PropCommentRw,
+		"default": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether this is a default item.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"enc_algorithms": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Description: "Allowed algorithms and key lengths to use for SAs. AES-GCM modes require the `null` " +
+				"authentication algorithm.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"null", "des", "3des", "aes-128-cbc", "aes-128-ctr",
+					"aes-128-gcm", "aes-192-cbc", "aes-192-ctr", "aes-192-gcm", "aes-256-cbc", "aes-256-ctr",
+					"aes-256-gcm", "blowfish", "camellia-128-cbc", "camellia-192-cbc", "camellia-256-cbc",
+					"twofish"}, false),
+			},
+		},
+		"lifetime": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "How long to use SA before throwing it out.",
+			ValidateFunc: ValidationTime,
+		},
+		KeyName: PropName("Proposal name."),
+		"pfs_group": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "The Diffie-Helman group used for Perfect Forward Secrecy. `none` disables the " +
+				"additional key exchange.",
+			ValidateFunc: validation.StringInSlice([]string{"none", "modp768", "modp1024", "modp1536", "modp2048",
+				"modp3072", "modp4096", "modp6144", "modp8192", "ecp256", "ecp384", "ecp521"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
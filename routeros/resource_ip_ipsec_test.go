@@ -0,0 +1,113 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testIPIpsecPeerAddress     = "routeros_ip_ipsec_peer.test"
+	testIPIpsecIdentityAddress = "routeros_ip_ipsec_identity.test"
+	testIPIpsecPolicyAddress   = "routeros_ip_ipsec_policy.test"
+)
+
+func TestAccIPIpsecTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/ip/ipsec/policy", "routeros_ip_ipsec_policy"),
+					testCheckResourceDestroy("/ip/ipsec/identity", "routeros_ip_ipsec_identity"),
+					testCheckResourceDestroy("/ip/ipsec/peer", "routeros_ip_ipsec_peer"),
+					testCheckResourceDestroy("/ip/ipsec/profile", "routeros_ip_ipsec_profile"),
+					testCheckResourceDestroy("/ip/ipsec/proposal", "routeros_ip_ipsec_proposal"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIPIpsecConfig("test_ipsec_peer"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckIPIpsecExists(testIPIpsecPeerAddress),
+							testAccCheckIPIpsecExists(testIPIpsecIdentityAddress),
+							testAccCheckIPIpsecExists(testIPIpsecPolicyAddress),
+							resource.TestCheckResourceAttr(testIPIpsecPeerAddress, "profile", "test_ipsec_profile"),
+							resource.TestCheckResourceAttr(testIPIpsecIdentityAddress, "peer", "test_ipsec_peer"),
+							resource.TestCheckResourceAttr(testIPIpsecPolicyAddress, "proposal", "test_ipsec_proposal"),
+						),
+					},
+					{
+						// Renaming the peer in place, the identity and the policy follow it.
+						Config: testAccIPIpsecConfig("test_ipsec_peer_renamed"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIPIpsecIdentityAddress, "peer", "test_ipsec_peer_renamed"),
+							resource.TestCheckResourceAttr(testIPIpsecPolicyAddress, "peer", "test_ipsec_peer_renamed"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckIPIpsecExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccIPIpsecConfig(peer string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_ip_ipsec_profile" "test" {
+	name          = "test_ipsec_profile"
+	dh_group      = ["modp2048"]
+	enc_algorithm = ["aes-256"]
+}
+
+resource "routeros_ip_ipsec_proposal" "test" {
+	name           = "test_ipsec_proposal"
+	enc_algorithms = ["aes-256-cbc"]
+	pfs_group      = "modp2048"
+}
+
+resource "routeros_ip_ipsec_peer" "test" {
+	name          = "%v"
+	address       = "192.0.2.1/32"
+	exchange_mode = "ike2"
+	profile       = routeros_ip_ipsec_profile.test.name
+}
+
+resource "routeros_ip_ipsec_identity" "test" {
+	peer   = routeros_ip_ipsec_peer.test.name
+	secret = "test_ipsec_secret"
+}
+
+resource "routeros_ip_ipsec_policy" "test" {
+	peer        = routeros_ip_ipsec_peer.test.name
+	proposal    = routeros_ip_ipsec_proposal.test.name
+	src_address = "10.255.1.0/24"
+	dst_address = "10.255.2.0/24"
+	tunnel      = true
+}
+`, peer)
+}
@@ -9,7 +9,9 @@ import (
 func ResourceIPRoute() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/route"),
-		MetaId:           PropId(Id),
+		MetaId:           PropId(CommentTag),
+		MetaNormalize:    PropNormalize(`"dst_address":"ip"`),
+		MetaReferences:   PropReferences(`"routing_table":"/routing/table"`),
 
 		"active": {
 			Type:        schema.TypeBool,
@@ -131,8 +133,9 @@ func ResourceIPRoute() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -2,6 +2,7 @@ package routeros
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,12 +32,25 @@ import (
   },
 */
 
+var ipServiceDefaultPorts = map[string]int{
+	"api":     8728,
+	"api-ssl": 8729,
+	"ftp":     21,
+	"ssh":     22,
+	"telnet":  23,
+	"winbox":  8291,
+	"www":     80,
+	"www-ssl": 443,
+}
+
 // https://help.mikrotik.com/docs/display/ROS/Services
 func ResourceIpService() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/service"),
 		MetaId:           PropId(Name),
 
+		KeyResetOnDestroy: PropResetOnDestroy,
+
 		"address": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -110,14 +124,29 @@ func ResourceIpService() *schema.Resource {
 		return ResourceRead(ctx, resSchema, d, m)
 	}
 
+	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// Factory defaults of the service, values in the Mikrotik notation!
+		service := d.Get("numbers").(string)
+		resetDefaults := MikrotikItem{
+			"numbers": service,
+			"port":    strconv.Itoa(ipServiceDefaultPorts[service]),
+		}
+		if service == "www-ssl" || service == "api-ssl" {
+			resetDefaults["certificate"] = "none"
+			resetDefaults["tls-version"] = "any"
+		}
+
+		return SystemResourceDelete(ctx, resSchema, resetDefaults, d, m)
+	}
+
 	return &schema.Resource{
 		CreateContext: resCreateUpdate,
 		ReadContext:   DefaultRead(resSchema),
 		UpdateContext: resCreateUpdate,
-		DeleteContext: DefaultSystemDelete(resSchema),
+		DeleteContext: resDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
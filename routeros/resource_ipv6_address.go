@@ -29,7 +29,8 @@ import (
 func ResourceIPv6Address() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ipv6/address"),
-		MetaId:           PropId(Id),
+		MetaId:           PropId(CommentTag),
+		MetaReferences:   PropReferences(`"interface":"/interface","from_pool":"/ipv6/pool"`),
 
 		"address": {
 			Type:     schema.TypeString,
@@ -143,8 +144,9 @@ func ResourceIPv6Address() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
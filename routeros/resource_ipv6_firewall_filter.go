@@ -12,6 +12,9 @@ func ResourceIPv6FirewallFilter() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ipv6/firewall/filter"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"connection_state":"list","dst_address":"ip","src_address":"ip"`),
+		MetaReferences: PropReferences(`"in_interface":"/interface","out_interface":"/interface","in_interface_list":"/interface/list",
+			"out_interface_list":"/interface/list","routing_mark":"/routing/table"`),
 
 		"action": {
 			Type:        schema.TypeString,
@@ -103,9 +106,10 @@ func ResourceIPv6FirewallFilter() *schema.Resource {
 			ValidateFunc: validation.IntBetween(0, 63),
 		},
 		"dst_address": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Matches packets which destination is equal to specified IP or falls into specified IP range.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Matches packets which destination is equal to specified IP or falls into specified IP range.",
+			ValidateFunc: ValidationIp(IpV6 | IpAddress | IpPrefix | IpRange | IpNegation),
 		},
 		"dst_address_list": {
 			Type:        schema.TypeString,
@@ -305,9 +309,10 @@ func ResourceIPv6FirewallFilter() *schema.Resource {
 			Description: "Matches packets marked by mangle facility with particular routing mark.",
 		},
 		"src_address": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Matches packets which source is equal to specified IP or falls into a specified IP range.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Matches packets which source is equal to specified IP or falls into a specified IP range.",
+			ValidateFunc: ValidationIp(IpV6 | IpAddress | IpPrefix | IpRange | IpNegation),
 		},
 		"src_address_list": {
 			Type:        schema.TypeString,
@@ -360,8 +365,9 @@ func ResourceIPv6FirewallFilter() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -9,7 +9,9 @@ import (
 func ResourceIPv6Route() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ipv6/route"),
-		MetaId:           PropId(Id),
+		MetaId:           PropId(CommentTag),
+		MetaNormalize:    PropNormalize(`"dst_address":"ip"`),
+		MetaReferences:   PropReferences(`"routing_table":"/routing/table"`),
 
 		"active": {
 			Type:        schema.TypeBool,
@@ -118,8 +120,9 @@ func ResourceIPv6Route() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
@@ -34,6 +34,7 @@ func ResourceOpenVPNServer() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/ovpn-server/server"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"keepalive_timeout":"duration"`),
 
 		"auth": {
 			Type:             schema.TypeString,
@@ -88,7 +89,6 @@ func ResourceOpenVPNServer() *schema.Resource {
 				"keepalive packets every second. If no traffic and no keepalive  responses have come for " +
 				"that period of time (i.e. 2 *  keepalive-timeout), not responding client is proclaimed " +
 				"disconnected",
-			DiffSuppressFunc: TimeEquall,
 		},
 		// Computed only???
 		"mac_address": {
@@ -34,6 +34,7 @@ func ResourcePPPProfile() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ppp/profile"),
 		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"idle_timeout":"duration","session_timeout":"duration"`),
 
 		"address_list": {
 			Type:     schema.TypeString,
@@ -117,7 +118,6 @@ func ResourcePPPProfile() *schema.Resource {
 			Optional: true,
 			Description: "Specifies  the amount of time after which the link will be terminated if there are  no " +
 				"activity present. Timeout is not set by default.",
-			DiffSuppressFunc: TimeEquall,
 		},
 		"incoming_filter": {
 			Type:     schema.TypeString,
@@ -211,10 +211,9 @@ func ResourcePPPProfile() *schema.Resource {
 			Description: "Assign prefix from IPv6 pool to the client and install corresponding IPv6 route.",
 		},
 		"session_timeout": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Description:      "Maximum time the connection can stay up. By default no time limit is set.",
-			DiffSuppressFunc: TimeEquall,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Maximum time the connection can stay up. By default no time limit is set.",
 		},
 		"use_compression": {
 			Type:     schema.TypeString,
@@ -281,7 +280,7 @@ func ResourcePPPProfile() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -30,6 +30,8 @@ func ResourcePPPSecret() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ppp/secret"),
 		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"profile":"/ppp/profile"`),
+		MetaSecrets:      PropSecrets(`"password"`),
 
 		"caller_id": {
 			Type:     schema.TypeString,
@@ -115,6 +117,7 @@ func ResourcePPPSecret() *schema.Resource {
 				Type: schema.TypeString,
 			},
 		},
+		KeySecretHashes: PropSecretHashesRo,
 		// The ROS 7.8 version does not contain the isdn option.
 		"service": {
 			Type:        schema.TypeString,
@@ -135,8 +138,9 @@ func ResourcePPPSecret() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
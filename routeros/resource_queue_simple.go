@@ -0,0 +1,226 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "bucket-size": "0.1/0.1",
+    "burst-limit": "0/0",
+    "burst-threshold": "0/0",
+    "burst-time": "0s/0s",
+    "bytes": "0/0",
+    "comment": "customer-42",
+    "disabled": "false",
+    "dropped": "0/0",
+    "dst": "",
+    "dynamic": "false",
+    "invalid": "false",
+    "limit-at": "0/0",
+    "max-limit": "10000000/10000000",
+    "name": "customer-42",
+    "packet-marks": "",
+    "packet-rate": "0/0",
+    "packets": "0/0",
+    "parent": "none",
+    "priority": "8/8",
+    "queue": "default-small/default-small",
+    "queued-bytes": "0/0",
+    "queued-packets": "0/0",
+    "rate": "0/0",
+    "target": "10.0.42.0/24",
+    "total-bytes": "0",
+    "total-dropped": "0",
+    "total-packet-rate": "0",
+    "total-packets": "0",
+    "total-queued-bytes": "0",
+    "total-queued-packets": "0",
+    "total-rate": "0"
+  }
+*/
+
+// ResourceQueueSimple https://help.mikrotik.com/docs/display/ROS/Queues#Queues-SimpleQueue
+func ResourceQueueSimple() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/queue/simple"),
+		MetaId:           PropId(Id),
+		MetaNormalize: PropNormalize(`"limit_at":"rate","max_limit":"rate","burst_limit":"rate",
+			"burst_threshold":"rate","target":"ip","dst":"ip"`),
+
+		"bucket_size": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "0.1/0.1",
+			Description: "Upload/download bucket size, the ratio of the bucket capacity to the max-limit " +
+				"(0.001..10).",
+		},
+		"burst_limit": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "0/0",
+			Description: "Maximal upload/download data rate which can be reached while the burst is active. " +
+				"The rates are accepted with the k, M, G suffixes: `20M/20M`.",
+		},
+		"burst_threshold": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "0/0",
+			Description: "When the average data rate is below this value - burst is allowed, as soon as the " +
+				"average data rate reaches this value - burst is denied (basically this is a burst on/off " +
+				"switch).",
+		},
+		"burst_time": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0s/0s",
+			Description: "Period of time, in seconds, over which the average upload/download data rate is calculated.",
+		},
+		"bytes": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of the upload/download bytes processed by the queue.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"dropped": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of the upload/download packets dropped by the queue.",
+		},
+		"dst": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Allows to select only specific stream (from target address to this destination " +
+				"address) for limitation.",
+		},
+		KeyDynamic: PropDynamicRo,
+		KeyInvalid: PropInvalidRo,
+		"limit_at": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0/0",
+			Description: "Normal upload/download data rate that is guaranteed to a target.",
+		},
+		"max_limit": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "0/0",
+			Description: "Maximal upload/download data rate that is allowed for a target to reach. The rates " +
+				"are accepted with the k, M, G suffixes: `10M/10M`.",
+		},
+		KeyName: PropName("Queue name."),
+		"packet_marks": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Allows to use marked packets from `/ip firewall mangle`.",
+		},
+		"packet_rate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The average upload/download packet rate of the queue.",
+		},
+		"packets": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of the upload/download packets processed by the queue.",
+		},
+		"parent": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "none",
+			Description: "Assigns this queue as a child queue for selected target.",
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"priority": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "8/8",
+			Description: "Prioritize one child queue over other child queue. Does not work on parent queues " +
+				"(if a queue has at least one child). One is the highest, eight is the lowest priority.",
+		},
+		"queue": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "default-small/default-small",
+			Description: "Choose the upload/download queue type.",
+		},
+		"queued_bytes": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of the upload/download bytes waiting in the queue.",
+		},
+		"queued_packets": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of the upload/download packets waiting in the queue.",
+		},
+		"rate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The average upload/download data rate of the queue.",
+		},
+		"target": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "List of IP address ranges or interfaces that will be limited by this queue: " +
+				"`10.0.42.0/24,ether5`.",
+		},
+		"time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Allow to specify time when particular queue will be active. The router must have " +
+				"correct time settings.",
+		},
+		"total_bytes": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of bytes processed by the total queue.",
+		},
+		"total_dropped": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of packets dropped by the total queue.",
+		},
+		"total_packet_rate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The average packet rate of the total queue.",
+		},
+		"total_packets": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of packets processed by the total queue.",
+		},
+		"total_queued_bytes": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of bytes waiting in the total queue.",
+		},
+		"total_queued_packets": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The number of packets waiting in the total queue.",
+		},
+		"total_rate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The average data rate of the total queue.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,110 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testQueueTypeAddress       = "routeros_queue_type.test"
+	testQueueSimpleAddress     = "routeros_queue_simple.test"
+	testQueueTreeParentAddress = "routeros_queue_tree.parent"
+	testQueueTreeChildAddress  = "routeros_queue_tree.child"
+)
+
+func TestAccQueueTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/queue/simple", "routeros_queue_simple"),
+					testCheckResourceDestroy("/queue/tree", "routeros_queue_tree"),
+					testCheckResourceDestroy("/queue/type", "routeros_queue_type"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccQueueConfig("10M/10M"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckQueueExists(testQueueTypeAddress),
+							testAccCheckQueueExists(testQueueSimpleAddress),
+							testAccCheckQueueExists(testQueueTreeParentAddress),
+							testAccCheckQueueExists(testQueueTreeChildAddress),
+							resource.TestCheckResourceAttr(testQueueTypeAddress, "kind", "pcq"),
+							resource.TestCheckResourceAttr(testQueueTreeChildAddress, "parent", "test_queue_parent"),
+						),
+					},
+					{
+						// The router returns the rates in bits, the plan must be empty.
+						Config:   testAccQueueConfig("10M/10M"),
+						PlanOnly: true,
+					},
+					{
+						Config: testAccQueueConfig("20M/20M"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testQueueSimpleAddress, "max_limit", "20M/20M"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckQueueExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccQueueConfig(maxLimit string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_queue_type" "test" {
+	name           = "test_queue_pcq"
+	kind           = "pcq"
+	pcq_rate       = "5M"
+	pcq_classifier = "dst-address"
+}
+
+resource "routeros_queue_simple" "test" {
+	name      = "test_queue_simple"
+	target    = "10.0.42.0/24"
+	max_limit = "%v"
+}
+
+resource "routeros_queue_tree" "parent" {
+	name      = "test_queue_parent"
+	parent    = "global"
+	max_limit = "100M"
+}
+
+resource "routeros_queue_tree" "child" {
+	name      = "test_queue_child"
+	parent    = routeros_queue_tree.parent.name
+	queue     = routeros_queue_type.test.name
+	max_limit = "50M"
+	priority  = 1
+}
+`, maxLimit)
+}
@@ -0,0 +1,163 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1000000",
+    "bucket-size": "0.1",
+    "burst-limit": "0",
+    "burst-threshold": "0",
+    "burst-time": "0s",
+    "bytes": "0",
+    "disabled": "false",
+    "dropped": "0",
+    "invalid": "false",
+    "limit-at": "0",
+    "max-limit": "100000000",
+    "name": "download",
+    "packet-mark": "",
+    "packet-rate": "0",
+    "packets": "0",
+    "parent": "global",
+    "priority": "8",
+    "queue": "default-small",
+    "queued-bytes": "0",
+    "queued-packets": "0",
+    "rate": "0"
+  }
+*/
+
+// ResourceQueueTree https://help.mikrotik.com/docs/display/ROS/Queues#Queues-QueueTree
+func ResourceQueueTree() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/queue/tree"),
+		MetaId:           PropId(Id),
+		MetaNormalize: PropNormalize(`"limit_at":"rate","max_limit":"rate","burst_limit":"rate",
+			"burst_threshold":"rate","burst_time":"duration","packet_mark":"list"`),
+
+		"bucket_size": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0.1",
+			Description: "The ratio of the bucket capacity to the max-limit (0.001..10).",
+		},
+		"burst_limit": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "0",
+			Description: "Maximal data rate which can be reached while the burst is active. The rates are " +
+				"accepted with the k, M, G suffixes: `20M`.",
+		},
+		"burst_threshold": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "0",
+			Description: "When the average data rate is below this value - burst is allowed, as soon as the " +
+				"average data rate reaches this value - burst is denied (basically this is a burst on/off " +
+				"switch).",
+		},
+		"burst_time": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "0s",
+			Description:  "Period of time, in seconds, over which the average data rate is calculated.",
+			ValidateFunc: ValidationTime,
+		},
+		"bytes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of bytes processed by the queue.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"dropped": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of packets dropped by the queue.",
+		},
+		KeyInvalid: PropInvalidRo,
+		"limit_at": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0",
+			Description: "Normal data rate that is guaranteed to the queue.",
+		},
+		"max_limit": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "0",
+			Description: "Maximal data rate that is allowed for the queue to reach. The rates are accepted with " +
+				"the k, M, G suffixes: `100M`.",
+		},
+		KeyName: PropName("Queue name."),
+		"packet_mark": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Comma-separated list of the packet marks from `/ip firewall mangle` to match.",
+		},
+		"packet_rate": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The average packet rate of the queue.",
+		},
+		"packets": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of packets processed by the queue.",
+		},
+		"parent": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "Assigns this queue as a child queue for the selected target. The target can be an " +
+				"interface, `global` or the name of another queue tree item, which makes this queue its child: " +
+				"`parent = routeros_queue_tree.download.name`.",
+		},
+		"priority": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  8,
+			Description: "Prioritize one child queue over other child queue. Does not work on parent queues " +
+				"(if a queue has at least one child). One is the highest, eight is the lowest priority.",
+			ValidateFunc: validation.IntBetween(1, 8),
+		},
+		"queue": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "default-small",
+			Description: "The queue type.",
+		},
+		"queued_bytes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of bytes waiting in the queue.",
+		},
+		"queued_packets": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of packets waiting in the queue.",
+		},
+		"rate": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The average data rate of the queue.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,339 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*14",
+    "default": "false",
+    "kind": "pcq",
+    "name": "pcq-download-10M",
+    "pcq-burst-rate": "0",
+    "pcq-burst-threshold": "0",
+    "pcq-burst-time": "10s",
+    "pcq-classifier": "dst-address",
+    "pcq-dst-address-mask": "32",
+    "pcq-dst-address6-mask": "128",
+    "pcq-limit": "50KiB",
+    "pcq-rate": "10000000",
+    "pcq-src-address-mask": "32",
+    "pcq-src-address6-mask": "128",
+    "pcq-total-limit": "2000KiB"
+  }
+*/
+
+// ResourceQueueType https://help.mikrotik.com/docs/display/ROS/Queues#Queues-QueueTypes
+func ResourceQueueType() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/queue/type"),
+		MetaId:           PropId(Name),
+		MetaNormalize: PropNormalize(`"pcq_rate":"rate","pcq_burst_rate":"rate","pcq_burst_threshold":"rate",
+			"cake_bandwidth":"rate","pcq_burst_time":"duration","fq_codel_interval":"duration",
+			"fq_codel_target":"duration","cake_rtt":"duration","pcq_classifier":"list"`),
+
+		"bfifo_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum number of bytes that the BFIFO queue can hold.",
+		},
+		"cake_ack_filter": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Filtering of the TCP ACK packets.",
+			ValidateFunc: validation.StringInSlice([]string{"aggressive", "filter", "none"}, false),
+		},
+		"cake_atm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Compensates for ATM cell framing, which is normally found on ADSL links.",
+			ValidateFunc: validation.StringInSlice([]string{"atm", "none", "ptm"}, false),
+		},
+		"cake_autorate_ingress": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Automatic capacity estimation based on traffic arriving at this qdisc.",
+		},
+		"cake_bandwidth": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Sets the shaper bandwidth: `100M`.",
+		},
+		"cake_diffserv": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "CAKE can divide traffic into 'tins' based on the Diffserv field: `besteffort`, " +
+				"`diffserv3`, `diffserv4`, `diffserv8`, `precedence`.",
+			ValidateFunc: validation.StringInSlice([]string{"besteffort", "diffserv3", "diffserv4", "diffserv8",
+				"precedence"}, false),
+		},
+		"cake_flowmode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "The flow isolation mode: `dsthost`, `dual-dsthost`, `dual-srchost`, `flowblind`, " +
+				"`flows`, `hosts`, `srchost`, `triple-isolate`.",
+			ValidateFunc: validation.StringInSlice([]string{"dsthost", "dual-dsthost", "dual-srchost", "flowblind",
+				"flows", "hosts", "srchost", "triple-isolate"}, false),
+		},
+		"cake_memlimit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Limit the memory consumed by CAKE, in bytes.",
+		},
+		"cake_mpu": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Rounds each packet (including overhead) up to a minimum length.",
+			ValidateFunc: validation.IntBetween(-64, 256),
+		},
+		"cake_nat": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Instructs CAKE to perform a NAT lookup before applying flow-isolation rules.",
+		},
+		"cake_overhead": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Adds the specified number of bytes to the size of each packet.",
+			ValidateFunc: validation.IntBetween(-64, 256),
+		},
+		"cake_overhead_scheme": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The shortcut for the link overhead settings: `docsis`, `ethernet`, `pppoe-ptm`, ...",
+		},
+		"cake_rtt": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Manually specify an RTT.",
+		},
+		"cake_rtt_scheme": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "The RTT preset: `datacentre`, `internet`, `interplanetary`, `lan`, `metro`, `none`, " +
+				"`oceanic`, `regional`, `satellite`.",
+			ValidateFunc: validation.StringInSlice([]string{"datacentre", "internet", "interplanetary", "lan",
+				"metro", "none", "oceanic", "regional", "satellite"}, false),
+		},
+		"cake_wash": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Apply the wash option to clear all extra DiffServ (but not ECN bits).",
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The default queue type.",
+		},
+		"fq_codel_ecn": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether to mark the ECN capable packets instead of dropping them.",
+		},
+		"fq_codel_flows": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The number of flows into which the incoming packets are classified.",
+		},
+		"fq_codel_interval": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Interval should be set on the order of the worst-case RTT through the bottleneck.",
+		},
+		"fq_codel_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The hard limit on the real queue size, in packets.",
+		},
+		"fq_codel_memlimit": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The total number of bytes that can be queued in this FQ-CoDel instance: `32.0MiB`.",
+		},
+		"fq_codel_quantum": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The number of bytes used as 'deficit' in the fair queuing algorithm.",
+		},
+		"fq_codel_target": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The acceptable minimum standing/persistent queue delay.",
+		},
+		"kind": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "Queue kind: `bfifo`, `cake`, `fq-codel`, `mq-pfifo`, `none`, `pcq`, `pfifo`, `red`, " +
+				"`sfq`. Only the parameters of the selected kind are used.",
+			ValidateFunc: validation.StringInSlice([]string{"bfifo", "cake", "fq-codel", "mq-pfifo", "none", "pcq",
+				"pfifo", "red", "sfq"}, false),
+		},
+		"mq_pfifo_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Multi-queue PFIFO limit, in packets.",
+		},
+		KeyName: PropName("Name of the queue type."),
+		"pcq_burst_rate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximal upload/download data rate which can be reached while the burst for the substream is allowed.",
+		},
+		"pcq_burst_threshold": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "This is the value of the burst on/off switch.",
+		},
+		"pcq_burst_time": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Period of time, in seconds, over which the average data rate is calculated.",
+		},
+		"pcq_classifier": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Selection of sub-stream identifiers: `dst-address`, `dst-port`, `src-address`, " +
+				"`src-port`.",
+			ValidateDiagFunc: ValidationMultiValInSlice([]string{"dst-address", "dst-port", "src-address",
+				"src-port"}, false, false),
+		},
+		"pcq_dst_address_mask": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The size of the IPv4 network that will be used as dst-address sub-stream identifier.",
+			ValidateFunc: validation.IntBetween(0, 32),
+		},
+		"pcq_dst_address6_mask": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The size of the IPv6 network that will be used as dst-address sub-stream identifier.",
+			ValidateFunc: validation.IntBetween(0, 128),
+		},
+		"pcq_limit": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Queue size of a single sub-stream: `50KiB`.",
+		},
+		"pcq_rate": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Maximal available data rate of each sub-steam. The rates are accepted with the k, M, G " +
+				"suffixes: `10M`.",
+		},
+		"pcq_src_address_mask": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The size of the IPv4 network that will be used as src-address sub-stream identifier.",
+			ValidateFunc: validation.IntBetween(0, 32),
+		},
+		"pcq_src_address6_mask": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The size of the IPv6 network that will be used as src-address sub-stream identifier.",
+			ValidateFunc: validation.IntBetween(0, 128),
+		},
+		"pcq_total_limit": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Max number of the packets or bytes in the total queue: `2000KiB`.",
+		},
+		"pfifo_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum number of packets that the PFIFO queue can hold.",
+		},
+		"red_avg_packet": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Used by RED for average queue size calculations.",
+		},
+		"red_burst": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Number of packets allowed for bursts of packets when there are no packets in the queue.",
+		},
+		"red_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "RED queue limit in packets.",
+		},
+		"red_max_threshold": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The average queue size at which packet marking probability is the highest.",
+		},
+		"red_min_threshold": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Average queue size in bytes.",
+		},
+		"sfq_allot": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Amount of data in bytes that can be sent in one round-robin round.",
+		},
+		"sfq_perturb": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "How often to change hash function, in seconds.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,64 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "chain": "bgp-in",
+    "comment": "",
+    "disabled": "false",
+    "inactive": "false",
+    "rule": "if (dst in 10.0.0.0/8 && dst-len > 24) { reject } else { accept }"
+  }
+*/
+
+// ResourceRoutingFilterRule https://help.mikrotik.com/docs/display/ROS/Route+Selection+and+Filters
+func ResourceRoutingFilterRule() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/routing/filter/rule"),
+		MetaId:           PropId(Id),
+
+		"chain": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "Chain name. The chain is referenced by the 'input.filter' and 'output.filter_chain' " +
+				"properties of the BGP connection or by the 'jump' command of another rule.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		KeyDynamic:  PropDynamicRo,
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"rule": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "The filter rule: `if (dst in 10.0.0.0/8 && dst-len > 24) { set distance 110; accept } " +
+				"else { reject }`. The rule is stored by the router reformatted, the differences in spacing and " +
+				"semicolons are ignored.",
+			ValidateFunc: ValidationRoutingFilterRule,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return RoutingFilterRuleEqual(old, new)
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
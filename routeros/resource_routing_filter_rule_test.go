@@ -0,0 +1,61 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testRoutingFilterRuleAddress = "routeros_routing_filter_rule.test"
+
+func TestAccRoutingFilterRuleTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/routing/filter/rule", "routeros_routing_filter_rule"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccRoutingFilterRuleConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckRoutingFilterRuleExists(testRoutingFilterRuleAddress),
+							resource.TestCheckResourceAttr(testRoutingFilterRuleAddress, "chain", "test-bgp-in"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccCheckRoutingFilterRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccRoutingFilterRuleConfig() string {
+	return providerConfig + `
+
+resource "routeros_routing_filter_rule" "test" {
+	chain = "test-bgp-in"
+	rule  = "if (dst in 10.0.0.0/8 && dst-len > 24) {reject} else {accept}"
+}
+`
+}
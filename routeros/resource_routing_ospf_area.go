@@ -0,0 +1,90 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*0",
+    "area-id": "0.0.0.0",
+    "disabled": "false",
+    "inactive": "false",
+    "instance": "default-v2",
+    "name": "backbone-v2",
+    "type": "default"
+  }
+*/
+
+// ResourceRoutingOspfArea https://help.mikrotik.com/docs/display/ROS/OSPF#OSPF-Area
+func ResourceRoutingOspfArea() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/routing/ospf/area"),
+		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"instance":"/routing/ospf/instance"`),
+
+		"area_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "0.0.0.0",
+			ForceNew:     true,
+			Description:  "OSPF area identifier. Default backbone area is `0.0.0.0`.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
+		},
+		KeyComment: PropCommentRw,
+		"default_cost": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Default cost of injected LSAs into the area.",
+			ValidateFunc: validation.IntBetween(0, 4294967295),
+		},
+		KeyDisabled: PropDisabledRw,
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"instance": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the OSPF instance this area belongs to.",
+		},
+		KeyName: PropName("The name of the area."),
+		"no_summaries": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If set then the area will not flood summary LSAs in the stub area.",
+		},
+		"nssa_translate": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "The parameter indicates which ABR will be used as a translator from type7 to type5 LSA. " +
+				"Applicable only if area type is NSSA: `no` - the router will never translate type7 LSAs; `yes` - " +
+				"the router will always translate type7 LSAs; `candidate` - the router will be elected as a " +
+				"translator.",
+			ValidateFunc: validation.StringInSlice([]string{"no", "yes", "candidate"}, false),
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "default",
+			Description:  "The area type.",
+			ValidateFunc: validation.StringInSlice([]string{"default", "nssa", "stub"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "advertise": "true",
+    "area": "backbone-v2",
+    "cost": "10",
+    "disabled": "false",
+    "inactive": "false",
+    "prefix": "10.0.0.0/16"
+  }
+*/
+
+// ResourceRoutingOspfAreaRange https://help.mikrotik.com/docs/display/ROS/OSPF#OSPF-AreaRange
+func ResourceRoutingOspfAreaRange() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/routing/ospf/area/range"),
+		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"area":"/routing/ospf/area"`),
+
+		"advertise": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to create a summary LSA and advertise it to the adjacent areas.",
+		},
+		"area": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The OSPF area associated with this range.",
+		},
+		KeyComment: PropCommentRw,
+		"cost": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "The cost of the summary LSA this range will create. If not set, the largest cost of " +
+				"all the included routes is used.",
+			ValidateFunc: validation.IntBetween(0, 16777215),
+		},
+		KeyDisabled: PropDisabledRw,
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"prefix": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The network prefix of this range.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpPrefix),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
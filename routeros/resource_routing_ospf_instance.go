@@ -0,0 +1,142 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*0",
+    "disabled": "false",
+    "in-filter-chain": "ospf-in",
+    "inactive": "false",
+    "name": "default-v2",
+    "originate-default": "never",
+    "redistribute": "connected,static",
+    "router-id": "main",
+    "routing-table": "main",
+    "version": "2",
+    "vrf": "main"
+  }
+*/
+
+// ResourceRoutingOspfInstance https://help.mikrotik.com/docs/display/ROS/OSPF#OSPF-Instance
+func ResourceRoutingOspfInstance() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/routing/ospf/instance"),
+		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"routing_table":"/routing/table"`),
+		MetaNormalize:    PropNormalize(`"redistribute":"list"`),
+
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"domain_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "MPLS-related parameter. Identifies the OSPF domain of the instance. This value is " +
+				"attached to OSPF routes redistributed in BGP as VPNv4 routes as BGP extended community attribute " +
+				"and used when BGP VPNv4 routes are redistributed back to OSPF to determine whether to generate " +
+				"inter-area or AS-external LSA for that route.",
+		},
+		"domain_tag": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "An MPLS-related parameter. If set, then used in route redistribution (as route-tag in all external LSAs generated by this instance).",
+			ValidateFunc: validation.IntBetween(0, 4294967295),
+		},
+		"in_filter_chain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the routing filter chain used for incoming prefixes.",
+		},
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"mpls_te_address": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The local interface address of the MPLS traffic engineering.",
+		},
+		"mpls_te_area": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The area used for MPLS traffic engineering.",
+		},
+		KeyName: PropName("The name of the OSPF instance."),
+		"originate_default": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Specifies default route (0.0.0.0/0) distribution method: `always` - always originate " +
+				"the default route; `if-installed` - originate only if the default route is active in the routing " +
+				"table; `never` - do not originate the default route.",
+			ValidateFunc: validation.StringInSlice([]string{"always", "if-installed", "never"}, false),
+		},
+		"out_filter_chain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the routing filter chain used for outgoing prefixes filtering.",
+		},
+		"out_filter_select": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the routing filter select chain, used for output selection.",
+		},
+		"redistribute": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Enable redistribution of specific routes: `bgp`, `connected`, `copy`, `dhcp`, `fantasy`, " +
+				"`modem`, `ospf`, `rip`, `static`, `vpn`.",
+			ValidateDiagFunc: ValidationMultiValInSlice([]string{"bgp", "connected", "copy", "dhcp", "fantasy",
+				"modem", "ospf", "rip", "static", "vpn"}, false, false),
+		},
+		"router_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "main",
+			Description: "Name of the router ID set in the `/routing/id` menu or an explicitly specified IP " +
+				"address of the OSPF router.",
+		},
+		"routing_table": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The routing table this OSPF instance operates on.",
+		},
+		"use_dn": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Forces to use or ignore the DN bit. Useful in some CE PE scenarios to inject intra area routes into VRF.",
+		},
+		"version": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ForceNew:     true,
+			Description:  "OSPF version this instance will be running (v2 for IPv4, v3 for IPv6).",
+			ValidateFunc: validation.IntInSlice([]int{2, 3}),
+		},
+		"vrf": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "main",
+			Description: "The VRF table this OSPF instance operates on.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,196 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*0",
+    "area": "backbone-v2",
+    "auth": "md5",
+    "auth-id": "1",
+    "auth-key": "********",
+    "cost": "10",
+    "dead-interval": "40s",
+    "disabled": "false",
+    "hello-interval": "10s",
+    "inactive": "false",
+    "instance-id": "0",
+    "interfaces": "ether2,ether3",
+    "networks": "10.0.0.0/24,10.0.1.0/24",
+    "passive": "",
+    "priority": "128",
+    "retransmit-interval": "5s",
+    "transmit-delay": "1s",
+    "type": "broadcast",
+    "use-bfd": "false"
+  }
+*/
+
+// ResourceRoutingOspfInterfaceTemplate https://help.mikrotik.com/docs/display/ROS/OSPF#OSPF-InterfaceTemplate
+func ResourceRoutingOspfInterfaceTemplate() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/routing/ospf/interface-template"),
+		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"area":"/routing/ospf/area"`),
+		MetaNormalize: PropNormalize(`"dead_interval":"duration","hello_interval":"duration",
+			"retransmit_interval":"duration","transmit_delay":"duration","passive":"flag"`),
+		MetaSecrets: PropSecrets(`"auth_key"`),
+
+		"area": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The OSPF area to which the matching interface will be associated.",
+		},
+		"auth": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies authentication method for OSPF protocol messages.",
+			ValidateFunc: validation.StringInSlice([]string{"md5", "sha1", "sha256", "sha384", "sha512", "simple"}, false),
+		},
+		"auth_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The key id is used to calculate message digest (used when MD5 or SHA authentication is enabled).",
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"auth_key": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			Description: "The authentication key to be used, should match on all the neighbors of the network " +
+				"segment.",
+		},
+		KeyComment: PropCommentRw,
+		"cost": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Interface cost expressed as link state metric.",
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"dead_interval": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "40s",
+			Description: "Specifies the interval after which a neighbor is declared dead. This interval is " +
+				"advertised in hello packets. This value must be the same for all routers on a specific network, " +
+				"otherwise adjacency between them will not form.",
+			ValidateFunc: ValidationTime,
+		},
+		KeyDisabled: PropDisabledRw,
+		"hello_interval": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "10s",
+			Description: "The interval between HELLO packets that the router sends out this interface. The " +
+				"smaller this interval is, the faster topology changes will be detected, but more routing traffic " +
+				"will ensue. This value must be the same on each end of the adjacency otherwise adjacency will " +
+				"not form.",
+			ValidateFunc: ValidationTime,
+		},
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"instance_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Interface cluster instance ID. Applicable only to OSPFv3.",
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"interfaces": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Interfaces or interface lists to match.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"networks": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Description: "The networks associated with the area. The network specifies the IP address range and " +
+				"is used to determine which interfaces will run OSPF.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: ValidationIp(IpV4 | IpV6 | IpPrefix),
+			},
+		},
+		"passive": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If enabled, then do not send or receive OSPF traffic on the matching interfaces.",
+		},
+		"prefix_list": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the address list containing networks that should be advertised to the v3 interface.",
+		},
+		"priority": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  128,
+			Description: "Router's priority. Used to determine the designated router in a broadcast network. The " +
+				"router with the highest priority value takes precedence.",
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"retransmit_interval": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "5s",
+			Description: "Time interval the lost link state advertisement will be resent. When a router sends a " +
+				"link state advertisement (LSA) to its neighbor, the LSA is kept until the acknowledgment is " +
+				"received. If no acknowledgment was received in time, the LSA is retransmitted.",
+			ValidateFunc: ValidationTime,
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"transmit_delay": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "1s",
+			Description: "Link-state transmit delay is the estimated time it takes to transmit a link-state " +
+				"update packet on the interface.",
+			ValidateFunc: ValidationTime,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "broadcast",
+			Description:  "The OSPF network type on this interface.",
+			ValidateFunc: validation.StringInSlice([]string{"broadcast", "nbma", "ptp", "ptmp", "ptp-unnumbered", "virtual-link"}, false),
+		},
+		"use_bfd": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to use the BFD protocol for faster connection state detection.",
+		},
+		"vlink_neighbor_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the router-id of the neighbor which should be connected over the virtual link.",
+			ValidateFunc: ValidationIp(IpV4 | IpAddress),
+		},
+		"vlink_transit_area": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A non-backbone area the two routers have in common over which the virtual link will be established.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
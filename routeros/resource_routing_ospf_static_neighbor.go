@@ -0,0 +1,74 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "address": "10.0.0.2%ether2",
+    "area": "backbone-v2",
+    "disabled": "false",
+    "inactive": "false",
+    "instance-id": "0",
+    "poll-interval": "2m"
+  }
+*/
+
+// ResourceRoutingOspfStaticNeighbor https://help.mikrotik.com/docs/display/ROS/OSPF#OSPF-StaticNeighbor
+func ResourceRoutingOspfStaticNeighbor() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/routing/ospf/static-neighbor"),
+		MetaId:           PropId(Id),
+		MetaReferences:   PropReferences(`"area":"/routing/ospf/area"`),
+		MetaNormalize:    PropNormalize(`"poll_interval":"duration"`),
+
+		"address": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "The unicast IP address of the neighbor. For OSPFv3 the link-local address with the " +
+				"interface is expected: `fe80::1%ether1`.",
+		},
+		"area": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The OSPF area associated with the neighbor.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"instance_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "The interface cluster ID. Applicable only to OSPFv3.",
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"poll_interval": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "2m",
+			Description: "How often to send hello messages to the neighbors which are in the `down` state " +
+				"(there is no traffic from the neighbor).",
+			ValidateFunc: ValidationTime,
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
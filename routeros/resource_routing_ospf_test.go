@@ -0,0 +1,117 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testRoutingOspfInstanceAddress          = "routeros_routing_ospf_instance.test"
+	testRoutingOspfAreaAddress              = "routeros_routing_ospf_area.test"
+	testRoutingOspfAreaRangeAddress         = "routeros_routing_ospf_area_range.test"
+	testRoutingOspfInterfaceTemplateAddress = "routeros_routing_ospf_interface_template.test"
+	testRoutingOspfStaticNeighborAddress    = "routeros_routing_ospf_static_neighbor.test"
+)
+
+func TestAccRoutingOspfTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/routing/ospf/static-neighbor", "routeros_routing_ospf_static_neighbor"),
+					testCheckResourceDestroy("/routing/ospf/interface-template", "routeros_routing_ospf_interface_template"),
+					testCheckResourceDestroy("/routing/ospf/area/range", "routeros_routing_ospf_area_range"),
+					testCheckResourceDestroy("/routing/ospf/area", "routeros_routing_ospf_area"),
+					testCheckResourceDestroy("/routing/ospf/instance", "routeros_routing_ospf_instance"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccRoutingOspfConfig("10"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckRoutingOspfExists(testRoutingOspfInstanceAddress),
+							testAccCheckRoutingOspfExists(testRoutingOspfAreaAddress),
+							testAccCheckRoutingOspfExists(testRoutingOspfAreaRangeAddress),
+							testAccCheckRoutingOspfExists(testRoutingOspfInterfaceTemplateAddress),
+							testAccCheckRoutingOspfExists(testRoutingOspfStaticNeighborAddress),
+							resource.TestCheckResourceAttr(testRoutingOspfAreaAddress, "instance", "test_ospf_instance"),
+							resource.TestCheckResourceAttr(testRoutingOspfInterfaceTemplateAddress, "networks.#", "2"),
+							resource.TestCheckResourceAttr(testRoutingOspfInterfaceTemplateAddress, "passive", "true"),
+							resource.TestCheckResourceAttr(testRoutingOspfInterfaceTemplateAddress, "cost", "10"),
+						),
+					},
+					{
+						Config: testAccRoutingOspfConfig("20"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testRoutingOspfInterfaceTemplateAddress, "cost", "20"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckRoutingOspfExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccRoutingOspfConfig(cost string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_routing_ospf_instance" "test" {
+	name         = "test_ospf_instance"
+	redistribute = "connected,static"
+}
+
+resource "routeros_routing_ospf_area" "test" {
+	name     = "test_ospf_area"
+	instance = routeros_routing_ospf_instance.test.name
+	area_id  = "0.0.0.1"
+}
+
+resource "routeros_routing_ospf_area_range" "test" {
+	area   = routeros_routing_ospf_area.test.name
+	prefix = "10.20.0.0/16"
+	cost   = 10
+}
+
+resource "routeros_routing_ospf_interface_template" "test" {
+	area       = routeros_routing_ospf_area.test.name
+	networks   = ["10.20.1.0/24", "10.20.2.0/24"]
+	interfaces = ["ether1"]
+	auth       = "sha256"
+	auth_id    = 1
+	auth_key   = "test_ospf_key"
+	cost       = %v
+	passive    = true
+}
+
+resource "routeros_routing_ospf_static_neighbor" "test" {
+	area    = routeros_routing_ospf_area.test.name
+	address = "10.20.1.2"
+}
+`, cost)
+}
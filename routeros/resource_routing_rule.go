@@ -0,0 +1,95 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "action": "lookup-only-in-table",
+    "disabled": "false",
+    "dst-address": "192.168.1.0/24",
+    "inactive": "false",
+    "interface": "ether2",
+    "src-address": "10.0.0.0/24",
+    "table": "to_ISP1"
+  }
+*/
+
+// ResourceRoutingRule https://help.mikrotik.com/docs/display/ROS/Policy+Routing#PolicyRouting-RoutingRules
+func ResourceRoutingRule() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/routing/rule"),
+		MetaId:           PropId(Id),
+		MetaNormalize:    PropNormalize(`"dst_address":"ip","src_address":"ip"`),
+		MetaReferences:   PropReferences(`"interface":"/interface","table":"/routing/table","routing_mark":"/routing/table"`),
+
+		"action": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "lookup",
+			Description: "An action to take on the matching packet: `drop` - silently drop the packet; `lookup` - " +
+				"perform a lookup in routing tables; `lookup-only-in-table` - perform lookup only in the specified " +
+				"routing table (see table parameter); `unreachable` - generate ICMP unreachable message and send it " +
+				"back to the source.",
+			ValidateFunc: validation.StringInSlice([]string{"drop", "lookup", "lookup-only-in-table", "unreachable"}, false),
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"dst_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The destination address of the packet to match.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix),
+		},
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"interface": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Incoming interface to match.",
+		},
+		"min_prefix": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Description: "Equivalent to Linux IP rule `suppress_prefixlength`. For example to suppress the default " +
+				"route in the routing decision set the value to 0.",
+			ValidateFunc: validation.IntBetween(0, 128),
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"routing_mark": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Match specific routing mark.",
+		},
+		"src_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The source address of the packet to match.",
+			ValidateFunc: ValidationIp(IpV4 | IpV6 | IpAddress | IpPrefix),
+		},
+		"table": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the routing table used to lookup the route.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
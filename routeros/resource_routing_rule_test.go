@@ -0,0 +1,67 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testRoutingRuleAddress = "routeros_routing_rule.test"
+
+func TestAccRoutingRuleTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/routing/rule", "routeros_routing_rule"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccRoutingRuleConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckRoutingRuleExists(testRoutingRuleAddress),
+							resource.TestCheckResourceAttr(testRoutingRuleAddress, "table", "test_rule_table"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccCheckRoutingRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccRoutingRuleConfig() string {
+	return providerConfig + `
+
+resource "routeros_routing_table" "test" {
+	name = "test_rule_table"
+	fib  = true
+}
+
+resource "routeros_routing_rule" "test" {
+	src_address = "10.0.0.0/24"
+	action      = "lookup-only-in-table"
+	table       = routeros_routing_table.test.name
+}
+`
+}
@@ -1,6 +1,10 @@
 package routeros
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,6 +26,7 @@ func ResourceUser() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/user"),
 		MetaId:           PropId(Id),
+		MetaSecrets:      PropSecrets(`"password"`),
 
 		"address": {
 			Type:        schema.TypeString,
@@ -55,18 +60,79 @@ func ResourceUser() *schema.Resource {
 			Computed:    true,
 			Description: "Read-only field. Last time and date when a user logged in.",
 		},
+		KeySecretHashes: PropSecretHashesRo,
+		KeyVerifySecret: PropVerifySecret("The password is verified by logging in to the router as this user, " +
+			"each login is written to the router log and counts towards the login rate limits. The user's group " +
+			"must allow the login over the provider's transport (the 'api' or 'rest-api' policy) and the 'address' " +
+			"must include the address of the Terraform host. Disabled users are not verified."),
+	}
+
+	resRead := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := ResourceRead(ctx, resSchema, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, userVerifyPassword(ctx, d, m.(Client))...)
 	}
 
 	return &schema.Resource{
 		CreateContext: DefaultCreate(resSchema),
-		ReadContext:   DefaultRead(resSchema),
+		ReadContext:   resRead,
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
 
 		Schema: resSchema,
 	}
 }
+
+// userVerifyPassword Logging in as the user with the password from the state.
+// Each login is written to the router log and counts towards the login rate limits, so the password is only
+// verified while the state has no hash of it (after the import). The verified password is hashed.
+func userVerifyPassword(ctx context.Context, d *schema.ResourceData, c Client) diag.Diagnostics {
+	if !d.Get(KeyVerifySecret).(bool) || d.Get(KeyDisabled).(bool) {
+		return nil
+	}
+
+	hashes := make(map[string]interface{})
+	for k, v := range d.Get(KeySecretHashes).(map[string]interface{}) {
+		hashes[k] = v
+	}
+	if h, ok := hashes["password"].(string); ok && h != "" {
+		return nil
+	}
+
+	name := d.Get(KeyName).(string)
+	password := d.Get("password").(string)
+	ok, err := c.CheckLogin(name, password)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unable to verify the password of the user '%v'", name),
+				Detail:   err.Error(),
+			},
+		}
+	}
+
+	if !ok {
+		ColorizedDebug(ctx, fmt.Sprintf("the password of the user '%v' was changed outside of Terraform", name))
+		if err = d.Set("password", secretChanged); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	if hashes["password"], err = secretHash(password); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(KeySecretHashes, hashes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -1,9 +1,11 @@
 package routeros
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -63,3 +65,42 @@ resource "routeros_system_user" "test" {
 }
 `
 }
+
+func Test_userVerifyPassword(t *testing.T) {
+	r := ResourceUser()
+	ctx := context.Background()
+
+	newData := func() *schema.ResourceData {
+		d := r.TestResourceData()
+		d.SetId("*1")
+		d.Set(KeyName, "test")
+		d.Set("password", "secret")
+		d.Set(KeyVerifySecret, true)
+		return d
+	}
+
+	// The password is verified once, then its hash is stored.
+	c := &testMenuClient{}
+	d := newData()
+	for i := 0; i < 2; i++ {
+		if diags := userVerifyPassword(ctx, d, c); diags.HasError() {
+			t.Fatal(diags)
+		}
+	}
+	if len(c.logins) != 1 {
+		t.Fatalf("expected a single login, got: %v", c.logins)
+	}
+	if h, _ := d.Get(KeySecretHashes).(map[string]interface{})["password"].(string); !secretMatches("secret", h) {
+		t.Fatalf("the hash of the verified password was not stored: %v", d.Get(KeySecretHashes))
+	}
+
+	// The password was changed outside of Terraform.
+	c = &testMenuClient{loginFail: true}
+	d = newData()
+	if diags := userVerifyPassword(ctx, d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Get("password") != secretChanged {
+		t.Fatalf("expected '%v', got '%v'", secretChanged, d.Get("password"))
+	}
+}
@@ -0,0 +1,150 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*5",
+    "arp": "enabled",
+    "arp-timeout": "auto",
+    "bound": "true",
+    "configuration": "cfg-home",
+    "configuration.mode": "ap",
+    "configuration.ssid": "guests",
+    "datapath": "dp-guests",
+    "default-name": "",
+    "disable-running-check": "false",
+    "disabled": "false",
+    "inactive": "false",
+    "l2mtu": "1560",
+    "mac-address": "4A:A5:6F:2E:1B:07",
+    "master": "false",
+    "master-interface": "wifi1",
+    "mtu": "1500",
+    "name": "wifi-guests",
+    "radio-mac": "48:A9:8A:2E:1B:07",
+    "running": "true",
+    "security.passphrase": "guests-passphrase"
+  }
+*/
+
+// ResourceWifi https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Interfaceproperties
+func ResourceWifi() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi"),
+		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"master_interface":"/interface/wifi"`),
+		MetaTransformSet: PropTransformSet(`"channel": "channel.config", "configuration": "configuration.config",
+		"datapath": "datapath.config", "security": "security.config", "steering": "steering.config"`),
+
+		KeyArp:        PropArpRw,
+		KeyArpTimeout: PropArpTimeoutRw,
+		"bound": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The interface is bound to a radio.",
+		},
+		"channel": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Channel inline settings, `config` is the name of the channel profile (`routeros_wifi_channel`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		KeyComment: PropCommentRw,
+		"configuration": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Description: "Configuration inline settings, `config` is the name of the configuration profile " +
+				"(`routeros_wifi_configuration`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"datapath": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Datapath inline settings, `config` is the name of the datapath profile (`routeros_wifi_datapath`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"default_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The default name of the physical interface.",
+		},
+		"disable_running_check": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "An option to set the running status of the interface to true, regardless of whether it is " +
+				"connected to anything.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"inactive": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		KeyL2Mtu: PropL2MtuRo,
+		KeyMacAddress: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "MAC address (BSSID) to use for the interface.",
+		},
+		"master": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "The interface is a physical (master) interface.",
+		},
+		"master_interface": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Description: "The physical interface of the virtual AP. The physical interfaces can not be created, " +
+				"they should be imported.",
+		},
+		KeyMtu:  PropMtuRw(),
+		KeyName: PropNameForceNewRw,
+		"radio_mac": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The MAC address of the associated radio.",
+		},
+		KeyRunning: PropRunningRo,
+		"security": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Security inline settings, `config` is the name of the security profile (`routeros_wifi_security`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"steering": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Steering inline settings, `config` is the name of the steering profile (`routeros_wifi_steering`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,113 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*2",
+    "action": "accept",
+    "disabled": "false",
+    "interface": "wifi-guests",
+    "mac-address": "00:11:22:33:44:55",
+    "passphrase": "device-passphrase",
+    "signal-range": "-80..120",
+    "vlan-id": "30"
+  }
+*/
+
+// ResourceWifiAccessList https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Accesslist
+func ResourceWifiAccessList() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/access-list"),
+		MetaId:           PropId(Id),
+		MetaSecrets:      PropSecrets(`"passphrase"`),
+
+		"action": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "An action to take when a client device matches.",
+			ValidateFunc: validation.StringInSlice([]string{"accept", "query-radius", "reject"}, false),
+		},
+		"allow_signal_out_of_range": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "An option that permits the client device to remain connected while its signal is out of " +
+				"the `signal_range` for the set period: `10s` or `always`.",
+		},
+		"client_isolation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "An option to prevent the matching client device from communicating with other client devices.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"interface": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The interface (or the interface list) to match: `wifi1`, `any`.",
+		},
+		"mac_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "MAC address of the client device.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		"mac_address_mask": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The bitmask applied to the MAC address of the client device before comparing it to `mac_address`.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		"passphrase": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The passphrase to use for the PSK authentication of the matching client device.",
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"radius_accounting": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to send the RADIUS accounting for the client devices authenticated by the RADIUS server.",
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"signal_range": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The range of the signal strength of the client device in dBm to match: `-80..-40`.",
+		},
+		"ssid_regexp": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The regular expression to match the SSID.",
+		},
+		"time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The time of the day and the days of the week to match: " +
+				"`7h-19h,mon,tue,wed,thu,fri`.",
+		},
+		"vlan_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The VLAN ID to assign to the traffic of the matching client device: `10` or `none`.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
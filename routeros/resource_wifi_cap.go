@@ -0,0 +1,125 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    "caps-man-addresses": "",
+    "caps-man-certificate-common-names": "",
+    "caps-man-names": "",
+    "certificate": "none",
+    "current-caps-man-address": "192.168.88.1",
+    "current-caps-man-identity": "capsman",
+    "discovery-interfaces": "bridge",
+    "enabled": "true",
+    "lock-to-caps-man": "false",
+    "locked-caps-man-common-name": "",
+    "requested-certificate": "",
+    "slaves-datapath": "dp-home",
+    "slaves-static": "false"
+  }
+*/
+
+// ResourceWifiCap https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-CAPConfiguration
+func ResourceWifiCap() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/cap"),
+		MetaId:           PropId(Name),
+
+		KeyResetOnDestroy: PropResetOnDestroy,
+
+		"caps_man_addresses": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "List of the CAPsMAN IP addresses to connect to, the discovery is used when the list is empty.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"caps_man_certificate_common_names": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "List of the CAPsMAN certificate common names the CAP will connect to.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"caps_man_names": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "List of the CAPsMAN names (identities) the CAP will connect to.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The certificate to use for the authentication: `none`, `request` or the certificate name.",
+		},
+		"current_caps_man_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The address of the CAPsMAN the CAP is connected to.",
+		},
+		"current_caps_man_identity": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identity of the CAPsMAN the CAP is connected to.",
+		},
+		"discovery_interfaces": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "List of the interfaces over which the CAP should attempt to discover CAPsMAN.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Disable or enable the CAP functionality.",
+		},
+		"lock_to_caps_man": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Lock the CAP to the first CAPsMAN it connects to.",
+		},
+		"locked_caps_man_common_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The common name of the CAPsMAN certificate the CAP is locked to.",
+		},
+		"requested_certificate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The certificate requested from CAPsMAN.",
+		},
+		"slaves_datapath": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The datapath profile (`routeros_wifi_datapath`) to use for the slave interfaces created by CAPsMAN.",
+		},
+		"slaves_static": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Create the slave interfaces as static (not dynamic) interfaces.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultSystemCreate(resSchema),
+		ReadContext:   DefaultSystemRead(resSchema),
+		UpdateContext: DefaultSystemUpdate(resSchema),
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
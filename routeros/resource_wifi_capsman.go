@@ -0,0 +1,99 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    "ca-certificate": "auto",
+    "certificate": "auto",
+    "enabled": "true",
+    "generated-ca-certificate": "WiFi-CAPsMAN-CA-48A98A2E1B06",
+    "generated-certificate": "WiFi-CAPsMAN-48A98A2E1B06",
+    "interfaces": "bridge",
+    "package-path": "",
+    "require-peer-certificate": "false",
+    "upgrade-policy": "none"
+  }
+*/
+
+// ResourceWifiCapsman https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-CAPsMANGlobalConfiguration
+func ResourceWifiCapsman() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/capsman"),
+		MetaId:           PropId(Name),
+
+		KeyResetOnDestroy: PropResetOnDestroy,
+
+		"ca_certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Device CA certificate: `auto`, `none` or the certificate name.",
+		},
+		"certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Device certificate: `auto`, `none` or the certificate name.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Disable or enable CAPsMAN functionality.",
+		},
+		"generated_ca_certificate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CA certificate generated by CAPsMAN when `ca_certificate` is `auto`.",
+		},
+		"generated_certificate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The certificate generated by CAPsMAN when `certificate` is `auto`.",
+		},
+		"interfaces": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "List of the interfaces on which CAPsMAN will listen for the CAP connections: `all`, `bridge`.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"package_path": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Folder location for the RouterOS packages. For example, use '/upgrade' to specify the " +
+				"upgrade folder from the files section. If empty string is set, CAPsMAN can use built-in RouterOS " +
+				"packages, note that in this case only CAPs with the same architecture as CAPsMAN will be upgraded.",
+		},
+		"require_peer_certificate": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Require all connecting CAPs to have a valid certificate.",
+		},
+		"upgrade_policy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Upgrade policy options.",
+			ValidateFunc: validation.StringInSlice([]string{"none", "require-same-version", "suggest-same-version"},
+				false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultSystemCreate(resSchema),
+		ReadContext:   DefaultSystemRead(resSchema),
+		UpdateContext: DefaultSystemUpdate(resSchema),
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
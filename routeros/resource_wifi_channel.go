@@ -0,0 +1,88 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "band": "5ghz-ax",
+    "disabled": "false",
+    "frequency": "5180-5240",
+    "name": "ch-5ghz",
+    "skip-dfs-channels": "10min-cac",
+    "width": "20/40/80mhz"
+  }
+*/
+
+// ResourceWifiChannel https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Channelproperties
+func ResourceWifiChannel() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/channel"),
+		MetaId:           PropId(Name),
+		MetaNormalize:    PropNormalize(`"reselect_interval":"duration"`),
+
+		"band": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Frequency band and wireless standard that will be used by the AP.",
+			ValidateFunc: validation.StringInSlice([]string{"2ghz-g", "2ghz-n", "2ghz-ax", "2ghz-be", "5ghz-a",
+				"5ghz-an", "5ghz-ac", "5ghz-ax", "5ghz-be", "6ghz-ax", "6ghz-be"}, false),
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"frequency": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Description: "Channel frequency value or range in MHz on which AP or station will operate: `2412`, " +
+				"`5180-5240`, `5180-5240:20`.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		KeyName: PropName("Name of the channel profile."),
+		"reselect_interval": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The interval after which the least occupied frequency is chosen: `1h` or `30m..1h` " +
+				"(a random value within the range).",
+		},
+		"secondary_frequency": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Specifies the second frequency that will be used for 80+80MHz configuration: `5775` or `disabled`.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"skip_dfs_channels": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Whether to avoid using channels on which channel availability check (listening for the presence of radar signals) is required.",
+			ValidateFunc: validation.StringInSlice([]string{"10min-cac", "all", "disabled"}, false),
+		},
+		"width": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Channel width.",
+			ValidateFunc: validation.StringInSlice([]string{"20mhz", "20/40mhz", "20/40mhz-Ce", "20/40mhz-eC",
+				"20/40/80mhz", "20/40/80+80mhz", "20/40/80/160mhz", "20/40/80/160/320mhz"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,181 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "channel": "ch-5ghz",
+    "channel.skip-dfs-channels": "all",
+    "country": "Latvia",
+    "datapath": "dp-home",
+    "disabled": "false",
+    "mode": "ap",
+    "name": "cfg-home",
+    "security": "sec-home",
+    "security.ft": "true",
+    "ssid": "home",
+    "steering": "steering-home"
+  }
+*/
+
+// ResourceWifiConfiguration https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Configurationproperties
+func ResourceWifiConfiguration() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/configuration"),
+		MetaId:           PropId(Name),
+		MetaTransformSet: PropTransformSet(`"channel": "channel.config", "datapath": "datapath.config",
+		"security": "security.config", "steering": "steering.config"`),
+
+		"antenna_gain": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "An option to override the antenna gain in dBi reported by the interface.",
+			ValidateFunc: validation.IntBetween(0, 30),
+		},
+		"beacon_interval": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Time interval between beacon frames: `100ms`.",
+		},
+		"chains": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Radio chains to use for receiving signals.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 7),
+			},
+		},
+		"channel": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Channel inline settings, `config` is the name of the channel profile (`routeros_wifi_channel`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		KeyComment: PropCommentRw,
+		"country": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Determines which regulatory domain restrictions are applied to an interface: `Latvia`, " +
+				"`United States`.",
+		},
+		"datapath": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Datapath inline settings, `config` is the name of the datapath profile (`routeros_wifi_datapath`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		KeyDisabled: PropDisabledRw,
+		"dtim_period": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "A period at which to transmit multicast traffic, when there are client devices in power save mode connected to the AP.",
+			ValidateFunc: validation.IntBetween(1, 255),
+		},
+		"hide_ssid": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Do not include the SSID in the beacon frames and do not respond to the probe requests without the SSID.",
+		},
+		"installation": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies whether the installation is indoor or outdoor, this limits the available frequencies.",
+			ValidateFunc: validation.StringInSlice([]string{"any", "indoor", "outdoor"}, false),
+		},
+		"manager": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Which manager (the local one or CAPsMAN) configures the interface.",
+			ValidateFunc: validation.StringInSlice([]string{"capsman", "capsman-or-local", "local"}, false),
+		},
+		"max_clients": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The maximum number of client devices that will be accepted by the AP.",
+		},
+		"mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Interface operation mode.",
+			ValidateFunc: validation.StringInSlice([]string{"ap", "station", "station-bridge"}, false),
+		},
+		"multicast_enhance": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Whether to convert the multicast frames to unicast frames for each client device.",
+			ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+		},
+		KeyName: PropName("Name of the configuration profile."),
+		"qos_classifier": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The source of the priority used for the QoS of the transmitted frames.",
+			ValidateFunc: validation.StringInSlice([]string{"dscp-high-3-bits", "priority"}, false),
+		},
+		"security": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Security inline settings, `config` is the name of the security profile (`routeros_wifi_security`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ssid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the wireless network, aka the (E)SSID.",
+		},
+		"station_roaming": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to enable the roaming of the interface in the station mode.",
+		},
+		"steering": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Steering inline settings, `config` is the name of the steering profile (`routeros_wifi_steering`).",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tx_chains": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Radio chains to use for transmitting signals.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 7),
+			},
+		},
+		"tx_power": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "A limit on the transmit power in dBm of the interface.",
+			ValidateFunc: validation.IntBetween(0, 40),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,75 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "bridge": "bridge",
+    "disabled": "false",
+    "name": "dp-guests",
+    "vlan-id": "20"
+  }
+*/
+
+// ResourceWifiDatapath https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Datapathproperties
+func ResourceWifiDatapath() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/datapath"),
+		MetaId:           PropId(Name),
+		MetaReferences:   PropReferences(`"bridge":"/interface/bridge","interface_list":"/interface/list"`),
+
+		"bridge": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Bridge interface to add the interface as a bridge port.",
+		},
+		"bridge_cost": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Spanning tree protocol cost of the bridge port.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"bridge_horizon": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Bridge horizon to use when adding as a bridge port.",
+		},
+		"client_isolation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to prevent the client devices connected to the same AP from communicating with each other.",
+		},
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		"interface_list": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "List to which add the interface as a member.",
+		},
+		KeyName: PropName("Name of the datapath profile."),
+		"vlan_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Default VLAN ID to assign to the client device traffic.",
+			ValidateFunc: validation.IntBetween(1, 4095),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,111 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "action": "create-dynamic-enabled",
+    "disabled": "false",
+    "master-configuration": "cfg-home",
+    "name-format": "%I-5ghz",
+    "slave-configurations": "cfg-guests",
+    "supported-bands": "5ghz-ax"
+  }
+*/
+
+// ResourceWifiProvisioning https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Provisioning
+func ResourceWifiProvisioning() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/provisioning"),
+		MetaId:           PropId(Id),
+		MetaReferences: PropReferences(`"master_configuration":"/interface/wifi/configuration",
+			"slave_configurations":"/interface/wifi/configuration"`),
+
+		"action": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "none",
+			Description: "Provisioning action.",
+			ValidateFunc: validation.StringInSlice([]string{"create-disabled", "create-enabled",
+				"create-dynamic-enabled", "none"}, false),
+		},
+		"address_ranges": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Match CAPs with IPs within the configured address ranges.",
+		},
+		KeyComment: PropCommentRw,
+		"common_name_regexp": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Regular expression to match radios by the common name of the CAP certificate.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"identity_regexp": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Regular expression to match radios by router identity.",
+		},
+		"master_configuration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "If action specifies to create interfaces, then a new master interface with its configuration " +
+				"set to this configuration profile will be created.",
+		},
+		"name_format": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Specify the format of the CAP interface name creation: `%I` (the identity of the CAP), " +
+				"`%C` (the common name of the certificate), `%r` (the radio MAC).",
+		},
+		KeyPlaceBefore: PropPlaceBefore,
+		"radio_mac": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "MAC address of radio to be matched, empty MAC (00:00:00:00:00:00) means match all MAC addresses.",
+			ValidateFunc: ValidationMacAddress,
+		},
+		"slave_configurations": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Description: "If action specifies to create interfaces, then a new slave interface for each configuration " +
+				"profile in this list is created.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"slave_name_format": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specify the format of the CAP slave interface name creation.",
+		},
+		"supported_bands": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Match radios by the supported wireless bands.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"2ghz-g", "2ghz-n", "2ghz-ax", "2ghz-be", "5ghz-a",
+					"5ghz-an", "5ghz-ac", "5ghz-ax", "5ghz-be", "6ghz-ax", "6ghz-be"}, false),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
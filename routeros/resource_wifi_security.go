@@ -0,0 +1,221 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+  {
+    ".id": "*1",
+    "authentication-types": "wpa2-psk,wpa3-psk",
+    "disabled": "false",
+    "ft": "true",
+    "ft-over-ds": "true",
+    "name": "sec-home",
+    "passphrase": "secret-passphrase",
+    "wps": "disable"
+  }
+*/
+
+// ResourceWifiSecurity https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Securityproperties
+func ResourceWifiSecurity() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/security"),
+		MetaId:           PropId(Name),
+		MetaNormalize: PropNormalize(`"ft_r0_key_lifetime":"duration","ft_reassociation_deadline":"duration",
+			"group_key_update":"duration"`),
+		MetaSecrets: PropSecrets(`"eap_password","passphrase"`),
+
+		"authentication_types": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Authentication types to enable on the interface.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"wpa-psk", "wpa2-psk", "wpa-eap", "wpa2-eap",
+					"wpa3-psk", "owe", "wpa3-eap", "wpa3-eap-192"}, false),
+			},
+		},
+		KeyComment: PropCommentRw,
+		"connect_group": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "APs within the same connect group do not allow more than 1 client device with the same MAC address.",
+		},
+		"connect_priority": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The priority of the connection to this AP when the client device with the same MAC address " +
+				"is connected to another AP of the same connect group: `0/1`.",
+		},
+		"dh_groups": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Identifiers of the elliptic curve cryptography groups to use in SAE (WPA3) authentication.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"19", "20", "21"}, false),
+			},
+		},
+		"disable_pmkid": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Do not include the PMKID in the first message of the 4-way handshake.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"eap_accounting": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Send accounting information to the RADIUS server for EAP-authenticated peers.",
+		},
+		"eap_anonymous_identity": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The anonymous identity used by the station in the outer layer of the EAP authentication.",
+		},
+		"eap_certificate_mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The policy of the RADIUS server certificate check by the station.",
+			ValidateFunc: validation.StringInSlice([]string{"dont-verify-certificate", "no-certificates",
+				"verify-certificate", "verify-certificate-with-crl"}, false),
+		},
+		"eap_methods": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "EAP methods to consider for authentication.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"peap", "tls", "ttls"}, false),
+			},
+		},
+		"eap_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The password used by the station in the EAP authentication.",
+		},
+		"eap_tls_certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The certificate used by the station in the EAP-TLS authentication.",
+		},
+		"eap_username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The username used by the station in the EAP authentication.",
+		},
+		"encryption": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "A list of ciphers to support for encrypting unicast traffic.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"ccmp", "ccmp-256", "gcmp", "gcmp-256", "tkip"}, false),
+			},
+		},
+		"ft": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to enable 802.11r fast BSS transitions (roaming).",
+		},
+		"ft_mobility_domain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The fast BSS transition mobility domain ID.",
+		},
+		"ft_over_ds": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to enable fast BSS transitions over DS (distributed system).",
+		},
+		"ft_preserve_vlanid": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to preserve the VLAN ID of the client device during the fast BSS transition.",
+		},
+		"ft_r0_key_lifetime": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The lifetime of the fast BSS transition PMK-R0 encryption key.",
+		},
+		"ft_reassociation_deadline": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Fast BSS transition reassociation deadline.",
+		},
+		"group_encryption": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The cipher to use for encrypting multicast traffic.",
+			ValidateFunc: validation.StringInSlice([]string{"ccmp", "ccmp-256", "gcmp", "gcmp-256", "tkip"}, false),
+		},
+		"group_key_update": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The interval at which the group temporal key (key for encrypting broadcast traffic) is renewed.",
+		},
+		"management_encryption": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The cipher to use for encrypting protected management frames.",
+			ValidateFunc: validation.StringInSlice([]string{"cmac", "cmac-256", "gmac", "gmac-256"}, false),
+		},
+		"management_protection": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Management frame protection (802.11w) mode.",
+			ValidateFunc: validation.StringInSlice([]string{"allowed", "disabled", "required"}, false),
+		},
+		KeyName: PropName("Name of the security profile."),
+		"owe_transition_interface": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The interface to use for the OWE transition mode.",
+		},
+		"passphrase": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The passphrase to use for PSK authentication types.",
+		},
+		"sae_anti_clogging_threshold": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The number of the in-progress SAE authentications after which the anti-clogging mechanism is used.",
+		},
+		"sae_max_failure_rate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The rate of the failed SAE (WPA3) associations per minute, at which the AP will stop processing new association requests.",
+		},
+		"sae_pwe": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The methods to support for deriving SAE password element.",
+			ValidateFunc: validation.StringInSlice([]string{"both", "hash-to-element", "hunting-and-pecking"}, false),
+		},
+		KeySecretHashes: PropSecretHashesRo,
+		"wps": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "WPS mode.",
+			ValidateFunc: validation.StringInSlice([]string{"disable", "push-button"}, false),
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
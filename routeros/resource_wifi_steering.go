@@ -0,0 +1,58 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+  {
+    ".id": "*1",
+    "disabled": "false",
+    "name": "steering-home",
+    "neighbor-group": "dynamic-home-2f8a1c3d",
+    "rrm": "true",
+    "wnm": "true"
+  }
+*/
+
+// ResourceWifiSteering https://help.mikrotik.com/docs/display/ROS/WiFi#WiFi-Steeringproperties
+func ResourceWifiSteering() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/wifi/steering"),
+		MetaId:           PropId(Name),
+
+		KeyComment:  PropCommentRw,
+		KeyDisabled: PropDisabledRw,
+		KeyName:     PropName("Name of the steering profile."),
+		"neighbor_group": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The neighbor group of the APs among which the client devices are steered.",
+		},
+		"rrm": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to send 802.11k radio resource management (neighbor report) information.",
+		},
+		"wnm": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to send 802.11v wireless network management (BSS transition management) requests.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+		CustomizeDiff: DefaultCustomizeDiff(resSchema),
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,186 @@
+package routeros
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testWifiChannelAddress       = "routeros_wifi_channel.test"
+	testWifiSecurityAddress      = "routeros_wifi_security.test"
+	testWifiDatapathAddress      = "routeros_wifi_datapath.test"
+	testWifiSteeringAddress      = "routeros_wifi_steering.test"
+	testWifiConfigurationAddress = "routeros_wifi_configuration.test"
+	testWifiAccessListAddress    = "routeros_wifi_access_list.test"
+	testWifiProvisioningAddress  = "routeros_wifi_provisioning.test"
+	testWifiCapsmanAddress       = "routeros_wifi_capsman.test"
+)
+
+func TestAccWifiTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy: resource.ComposeTestCheckFunc(
+					testCheckResourceDestroy("/interface/wifi/provisioning", "routeros_wifi_provisioning"),
+					testCheckResourceDestroy("/interface/wifi/access-list", "routeros_wifi_access_list"),
+					testCheckResourceDestroy("/interface/wifi/configuration", "routeros_wifi_configuration"),
+					testCheckResourceDestroy("/interface/wifi/steering", "routeros_wifi_steering"),
+					testCheckResourceDestroy("/interface/wifi/datapath", "routeros_wifi_datapath"),
+					testCheckResourceDestroy("/interface/wifi/security", "routeros_wifi_security"),
+					testCheckResourceDestroy("/interface/wifi/channel", "routeros_wifi_channel"),
+				),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccWifiConfig("test_ssid"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckWifiExists(testWifiChannelAddress),
+							testAccCheckWifiExists(testWifiSecurityAddress),
+							testAccCheckWifiExists(testWifiDatapathAddress),
+							testAccCheckWifiExists(testWifiSteeringAddress),
+							testAccCheckWifiExists(testWifiConfigurationAddress),
+							testAccCheckWifiExists(testWifiAccessListAddress),
+							testAccCheckWifiExists(testWifiProvisioningAddress),
+							testAccCheckWifiExists(testWifiCapsmanAddress),
+							resource.TestCheckResourceAttr(testWifiConfigurationAddress, "ssid", "test_ssid"),
+							resource.TestCheckResourceAttr(testWifiConfigurationAddress, "channel.config", "test_wifi_channel"),
+							resource.TestCheckResourceAttr(testWifiConfigurationAddress, "security.ft", "true"),
+							resource.TestCheckResourceAttr(testWifiSecurityAddress, "authentication_types.#", "2"),
+							resource.TestCheckResourceAttr(testWifiProvisioningAddress, "master_configuration", "test_wifi_configuration"),
+						),
+					},
+					{
+						Config: testAccWifiConfig("test_ssid_new"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testWifiConfigurationAddress, "ssid", "test_ssid_new"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckWifiExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no id is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccWifiConfig(ssid string) string {
+	return fmt.Sprintf(`
+
+provider "routeros" {
+	insecure = true
+}
+
+resource "routeros_wifi_channel" "test" {
+	name              = "test_wifi_channel"
+	band              = "5ghz-ax"
+	frequency         = ["5180-5240"]
+	skip_dfs_channels = "10min-cac"
+	width             = "20/40/80mhz"
+}
+
+resource "routeros_wifi_security" "test" {
+	name                 = "test_wifi_security"
+	authentication_types = ["wpa2-psk", "wpa3-psk"]
+	passphrase           = "test_wifi_passphrase"
+}
+
+resource "routeros_wifi_datapath" "test" {
+	name             = "test_wifi_datapath"
+	client_isolation = true
+	vlan_id          = 20
+}
+
+resource "routeros_wifi_steering" "test" {
+	name = "test_wifi_steering"
+	rrm  = true
+	wnm  = true
+}
+
+resource "routeros_wifi_configuration" "test" {
+	name    = "test_wifi_configuration"
+	country = "Latvia"
+	mode    = "ap"
+	ssid    = "%v"
+
+	channel = {
+		config = routeros_wifi_channel.test.name
+	}
+	datapath = {
+		config = routeros_wifi_datapath.test.name
+	}
+	security = {
+		config = routeros_wifi_security.test.name
+		ft     = true
+	}
+	steering = {
+		config = routeros_wifi_steering.test.name
+	}
+}
+
+resource "routeros_wifi_access_list" "test" {
+	action       = "accept"
+	interface    = "any"
+	signal_range = "-80..120"
+	vlan_id      = "30"
+}
+
+resource "routeros_wifi_provisioning" "test" {
+	action               = "create-dynamic-enabled"
+	master_configuration = routeros_wifi_configuration.test.name
+	supported_bands      = ["5ghz-ax"]
+}
+
+resource "routeros_wifi_capsman" "test" {
+	enabled    = true
+	interfaces = ["all"]
+}
+`, ssid)
+}
+
+func Test_wifiConfigurationTransformSet(t *testing.T) {
+	s := ResourceWifiConfiguration().Schema
+
+	item := MikrotikItem{
+		".id":                       "*1",
+		"name":                      "test",
+		"channel":                   "ch-5ghz",
+		"channel.skip-dfs-channels": "all",
+		"security":                  "sec-home",
+		"security.ft":               "true",
+	}
+
+	d := ResourceWifiConfiguration().TestResourceData()
+	if diags := MikrotikResourceDataToTerraform(item, s, d); diags.HasError() {
+		t.Fatalf("decoding err: %v", diags)
+	}
+
+	for key, expected := range map[string]interface{}{
+		"channel":  map[string]interface{}{"config": "ch-5ghz", "skip_dfs_channels": "all"},
+		"security": map[string]interface{}{"config": "sec-home", "ft": "true"},
+	} {
+		if actual := d.Get(key); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("bad: (key: %v) expected:%#v\nactual:%#v", key, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-routeros/terraform-provider-routeros/routeros"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// refInterface Any resource of the /interface/<type> menu.
+const refInterface = "<interface>"
+
+// references Fields whose value is the name of another object and the resource types that can own that name.
+var references = map[string][]string{
+	"bridge":               {"routeros_interface_bridge"},
+	"interface":            {refInterface},
+	"in_interface":         {refInterface},
+	"out_interface":        {refInterface},
+	"list":                 {"routeros_interface_list"},
+	"interface_list":       {"routeros_interface_list"},
+	"in_interface_list":    {"routeros_interface_list"},
+	"out_interface_list":   {"routeros_interface_list"},
+	"address_pool":         {"routeros_ip_pool"},
+	"next_pool":            {"routeros_ip_pool"},
+	"server":               {"routeros_ip_dhcp_server"},
+	"profile":              {"routeros_ppp_profile"},
+	"routing_table":        {"routeros_routing_table"},
+	"new_routing_mark":     {"routeros_routing_table"},
+	"templates":            {"routeros_routing_bgp_template"},
+	"certificate":          {"routeros_system_certificate"},
+	"master_configuration": {"routeros_capsman_configuration"},
+}
+
+var reLabel = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// object A single generated resource block.
+type object struct {
+	typ       string
+	label     string
+	id        string
+	name      string
+	singleton bool
+	item      routeros.MikrotikItem
+	d         *schema.ResourceData
+}
+
+type generator struct {
+	resources map[string]*schema.Resource
+	types     []string
+	objects   []*object
+	labels    map[string]map[string]struct{}
+	names     map[string]map[string][]*object
+}
+
+func newGenerator(resources map[string]*schema.Resource) *generator {
+	return &generator{
+		resources: resources,
+		types:     canonicalTypes(resources),
+		labels:    make(map[string]map[string]struct{}),
+		names:     make(map[string]map[string][]*object),
+	}
+}
+
+// read Reads all items of the resource menu and adds the objects that must be managed by Terraform.
+func (g *generator) read(typ string, c routeros.Client) error {
+	r := g.resources[typ]
+	metadata := routeros.GetMetadata(r.Schema)
+
+	res, err := routeros.ReadItems(nil, metadata.Path, c)
+	if err != nil {
+		return err
+	}
+	items, singleton := *res, false
+
+	if len(items) == 0 || (len(items) == 1 && items[0].GetID(routeros.Id) == "") {
+		// Settings menus (/ip/dns, /system/identity) contain a single item without an ID.
+		item, err := routeros.ReadSystemItem(metadata.Path, c)
+		if err != nil {
+			return err
+		}
+		if len(item) == 0 {
+			return nil
+		}
+		items, singleton = []routeros.MikrotikItem{item}, true
+	}
+
+	for _, item := range items {
+		g.add(typ, item, singleton)
+	}
+	return nil
+}
+
+// add Adds the item as a new object unless it is created by the router itself.
+func (g *generator) add(typ string, item routeros.MikrotikItem, singleton bool) {
+	r := g.resources[typ]
+	metadata := routeros.GetMetadata(r.Schema)
+
+	if !singleton && (routeros.BoolFromMikrotikJSON(item[routeros.KeyDynamic]) ||
+		routeros.BoolFromMikrotikJSON(item["builtin"]) || routeros.BoolFromMikrotikJSON(item["default"])) {
+		return
+	}
+
+	d := r.Data(nil)
+	if diags := routeros.MikrotikResourceDataToTerraform(item, r.Schema, d); diags.HasError() {
+		log.Printf("[%v] item %v skipped: %v", typ, item.GetID(metadata.IdType), diags[0].Summary)
+		return
+	}
+
+	if missing := missingRequired(r.Schema, d); missing != "" {
+		log.Printf("[%v] item %v skipped: the required field '%v' is not returned by the router",
+			typ, item.GetID(metadata.IdType), missing)
+		return
+	}
+
+	o := &object{typ: typ, item: item, d: d, singleton: singleton, name: item[routeros.KeyName]}
+	if singleton {
+		o.id = strings.ReplaceAll(strings.TrimLeft(metadata.Path, "/"), "/", ".")
+	} else {
+		o.id = item.GetID(metadata.IdType)
+	}
+	o.label = g.newLabel(o, item)
+
+	g.objects = append(g.objects, o)
+	if o.name != "" {
+		if g.names[typ] == nil {
+			g.names[typ] = make(map[string][]*object)
+		}
+		g.names[typ][o.name] = append(g.names[typ][o.name], o)
+	}
+}
+
+func missingRequired(s map[string]*schema.Schema, d *schema.ResourceData) string {
+	var fields []string
+	for k := range s {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	for _, k := range fields {
+		if !s[k].Required || s[k].Type == schema.TypeBool {
+			continue
+		}
+		if _, ok := d.GetOk(k); !ok {
+			return k
+		}
+	}
+	return ""
+}
+
+// newLabel Builds a unique resource label from the name or comment of the item.
+func (g *generator) newLabel(o *object, item routeros.MikrotikItem) string {
+	short := strings.TrimPrefix(o.typ, "routeros_")
+
+	// The comment without the provider-managed tag.
+	var comment string
+	if _, ok := g.resources[o.typ].Schema[routeros.KeyComment]; ok {
+		comment = o.d.Get(routeros.KeyComment).(string)
+	}
+
+	var label string
+	switch {
+	case o.singleton:
+		label = short
+	case item[routeros.KeyName] != "":
+		label = sanitizeLabel(item[routeros.KeyName])
+	case comment != "":
+		label = sanitizeLabel(comment)
+	default:
+		label = sanitizeLabel(short + "_" + strings.TrimLeft(o.id, "*"))
+	}
+
+	if g.labels[o.typ] == nil {
+		g.labels[o.typ] = make(map[string]struct{})
+	}
+	unique := label
+	for i := 2; ; i++ {
+		if _, ok := g.labels[o.typ][unique]; !ok {
+			break
+		}
+		unique = fmt.Sprintf("%v_%v", label, i)
+	}
+	g.labels[o.typ][unique] = struct{}{}
+
+	return unique
+}
+
+// sanitizeLabel Converts an arbitrary string to a valid Terraform identifier.
+func sanitizeLabel(s string) string {
+	res := strings.Trim(reLabel.ReplaceAllString(strings.ToLower(s), "_"), "_")
+	if res == "" || (res[0] >= '0' && res[0] <= '9') {
+		res = "r_" + res
+	}
+	return res
+}
+
+// reference Returns the Terraform address of the object that owns the name, if it can be resolved unambiguously.
+func (g *generator) reference(self *object, field, value string) hcl.Traversal {
+	if value == "" {
+		return nil
+	}
+
+	types := g.candidates(self.typ, field)
+
+	var found []*object
+	for _, t := range types {
+		for _, o := range g.names[t][value] {
+			if o != self {
+				found = append(found, o)
+			}
+		}
+	}
+	if len(found) != 1 {
+		return nil
+	}
+
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: found[0].typ},
+		hcl.TraverseAttr{Name: found[0].label},
+		hcl.TraverseAttr{Name: routeros.KeyName},
+	}
+}
+
+// candidates Returns the resource types that can own the name referenced by the field.
+// The references declared in the schema take precedence over the well-known field names.
+func (g *generator) candidates(typ, field string) []string {
+	var res []string
+
+	if ref, ok := routeros.GetReferences(g.resources[typ].Schema)[field]; ok && ref.Field == routeros.KeyName {
+		if ref.Path == "/interface" {
+			return g.interfaceTypes()
+		}
+		for _, t := range g.types {
+			if routeros.GetMetadata(g.resources[t].Schema).Path == ref.Path {
+				res = append(res, t)
+			}
+		}
+		if len(res) > 0 {
+			return res
+		}
+	}
+
+	for _, t := range references[field] {
+		if t == refInterface {
+			res = append(res, g.interfaceTypes()...)
+		} else {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// interfaceTypes Resources of the /interface/<type> menus that create a named interface.
+func (g *generator) interfaceTypes() []string {
+	var res []string
+	for _, t := range g.types {
+		s := g.resources[t].Schema
+		path := strings.Split(strings.Trim(routeros.GetMetadata(s).Path, "/"), "/")
+		if len(path) != 2 || path[0] != "interface" || path[1] == "list" {
+			continue
+		}
+		if _, ok := s[routeros.KeyName]; ok {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// files Renders all objects: one file per resource type and a file with the import blocks.
+func (g *generator) files() map[string][]byte {
+	res := make(map[string][]byte)
+	bodies := make(map[string]*hclwrite.File)
+
+	imports := hclwrite.NewEmptyFile()
+	for _, o := range g.objects {
+		f, ok := bodies[o.typ]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			bodies[o.typ] = f
+		} else {
+			f.Body().AppendNewline()
+		}
+
+		block := f.Body().AppendNewBlock("resource", []string{o.typ, o.label})
+		g.writeBody(o, block.Body(), g.resources[o.typ].Schema, o.d, "")
+
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		ib := imports.Body().AppendNewBlock("import", nil).Body()
+		ib.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: o.typ}, hcl.TraverseAttr{Name: o.label}})
+		ib.SetAttributeValue("id", cty.StringVal(o.id))
+	}
+
+	for t, f := range bodies {
+		res[strings.TrimPrefix(t, "routeros_")+".tf"] = f.Bytes()
+	}
+	if len(g.objects) > 0 {
+		res["imports.tf"] = imports.Bytes()
+	}
+	return res
+}
+
+// writeBody Writes all configurable fields that differ from the schema defaults.
+// prefix is the path of the nested block in the resource data: "rule.0."
+func (g *generator) writeBody(o *object, body *hclwrite.Body, s map[string]*schema.Schema, d *schema.ResourceData, prefix string) {
+	var fields []string
+	for k := range s {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	for _, k := range fields {
+		sch := s[k]
+		if strings.HasPrefix(k, "___") || k == routeros.KeyPlaceBefore || (!sch.Optional && !sch.Required) {
+			continue
+		}
+
+		v, ok := d.GetOk(prefix + k)
+		if sch.Default != nil {
+			// Fields that are not returned by the router keep the default value.
+			if _, exists := o.item[routeros.SnakeToKebab(k)]; !exists && prefix == "" {
+				continue
+			}
+			v = d.Get(prefix + k)
+			if reflect.DeepEqual(v, sch.Default) {
+				continue
+			}
+		} else if !ok {
+			continue
+		}
+
+		if elem, ok := sch.Elem.(*schema.Resource); ok {
+			n := d.Get(prefix + k + ".#").(int)
+			for i := 0; i < n; i++ {
+				nested := body.AppendNewBlock(k, nil)
+				g.writeBody(o, nested.Body(), elem.Schema, d, fmt.Sprintf("%v%v.%v.", prefix, k, i))
+			}
+			continue
+		}
+
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+
+		if tokens := g.referenceTokens(o, k, v, prefix); tokens != nil {
+			body.SetAttributeRaw(k, tokens)
+			continue
+		}
+		body.SetAttributeValue(k, toCty(v))
+	}
+}
+
+// referenceTokens Returns the attribute expression with Terraform references instead of names,
+// or nil if no reference can be resolved.
+func (g *generator) referenceTokens(o *object, field string, v interface{}, prefix string) hclwrite.Tokens {
+	if prefix != "" {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case string:
+		if ref := g.reference(o, field, v); ref != nil {
+			return hclwrite.TokensForTraversal(ref)
+		}
+	case []interface{}:
+		var elems []hclwrite.Tokens
+		var resolved bool
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil
+			}
+			if ref := g.reference(o, field, s); ref != nil {
+				elems = append(elems, hclwrite.TokensForTraversal(ref))
+				resolved = true
+			} else {
+				elems = append(elems, hclwrite.TokensForValue(cty.StringVal(s)))
+			}
+		}
+		if resolved {
+			return hclwrite.TokensForTuple(elems)
+		}
+	}
+	return nil
+}
+
+func toCty(v interface{}) cty.Value {
+	switch v := v.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal
+		}
+		elems := make([]cty.Value, len(v))
+		for i := range v {
+			elems[i] = toCty(v[i])
+		}
+		return cty.TupleVal(elems)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+		attrs := make(map[string]cty.Value, len(v))
+		for k := range v {
+			attrs[k] = toCty(v[k])
+		}
+		return cty.ObjectVal(attrs)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/terraform-routeros/terraform-provider-routeros/routeros"
+)
+
+func Test_generator(t *testing.T) {
+	g := newGenerator(routeros.Provider().ResourcesMap)
+
+	g.add("routeros_interface_bridge", routeros.MikrotikItem{".id": "*1", "name": "bridge-lan", "vlan-filtering": "true"}, false)
+	g.add("routeros_interface_bridge_port", routeros.MikrotikItem{".id": "*2", "bridge": "bridge-lan", "interface": "ether2",
+		"pvid": "1"}, false)
+	g.add("routeros_interface_bridge_port", routeros.MikrotikItem{".id": "*3", "bridge": "bridge-lan", "interface": "ether3",
+		"dynamic": "true"}, false)
+	g.add("routeros_system_identity", routeros.MikrotikItem{"name": "router"}, true)
+
+	files := g.files()
+
+	port := string(files["interface_bridge_port.tf"])
+	if !strings.Contains(port, `resource "routeros_interface_bridge_port" "interface_bridge_port_2"`) {
+		t.Errorf("unexpected label:\n%v", port)
+	}
+	if !strings.Contains(port, "bridge    = routeros_interface_bridge.bridge_lan.name") {
+		t.Errorf("bridge reference not resolved:\n%v", port)
+	}
+	if !strings.Contains(port, `interface = "ether2"`) {
+		t.Errorf("unresolved interface must be kept as is:\n%v", port)
+	}
+	if strings.Contains(port, "ether3") {
+		t.Errorf("dynamic item must be skipped:\n%v", port)
+	}
+
+	imports := string(files["imports.tf"])
+	for _, s := range []string{
+		"to = routeros_interface_bridge.bridge_lan",
+		`id = "bridge-lan"`,
+		"to = routeros_system_identity.system_identity",
+		`id = "system.identity"`,
+	} {
+		if !strings.Contains(imports, s) {
+			t.Errorf("imports.tf does not contain %q:\n%v", s, imports)
+		}
+	}
+}
+
+func Test_sanitizeLabel(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"bridge-lan", "bridge_lan"},
+		{"WAN Uplink #1", "wan_uplink_1"},
+		{"10.0.0.0/24", "r_10_0_0_0_24"},
+		{"--", "r_"},
+	}
+	for _, tt := range tests {
+		if got := sanitizeLabel(tt.in); got != tt.want {
+			t.Errorf("sanitizeLabel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func Test_generatorSchemaReferences(t *testing.T) {
+	g := newGenerator(routeros.Provider().ResourcesMap)
+
+	g.add("routeros_ppp_profile", routeros.MikrotikItem{".id": "*1", "name": "s2s"}, false)
+	g.add("routeros_ip_ipsec_profile", routeros.MikrotikItem{".id": "*2", "name": "s2s"}, false)
+	g.add("routeros_ip_ipsec_peer", routeros.MikrotikItem{".id": "*3", "name": "office-b", "address": "203.0.113.10/32",
+		"profile": "s2s"}, false)
+
+	// The 'profile' field of the peer references the IPsec profile, not the PPP profile with the same name.
+	peer := string(g.files()["ip_ipsec_peer.tf"])
+	if !strings.Contains(peer, "profile = routeros_ip_ipsec_profile.s2s.name") {
+		t.Errorf("profile reference not resolved:\n%v", peer)
+	}
+}
@@ -0,0 +1,129 @@
+// ros2tf generates Terraform configuration from an existing RouterOS configuration.
+//
+// The command connects to the router with the same settings as the provider (flags or the ROS_* / MIKROTIK_*
+// environment variables), reads every menu known to the provider and writes one <resource type>.tf file per
+// resource type and an imports.tf file with the import blocks for all generated resources:
+//
+//	go run ./tools/ros2tf -out ./generated
+//	cd ./generated && terraform plan
+//
+// Dynamic, built-in and default entries are skipped. Fields that point to other generated objects
+// (for example, a bridge port's bridge) are written as Terraform references.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-routeros/terraform-provider-routeros/routeros"
+)
+
+func main() {
+	var (
+		outDir        = flag.String("out", ".", "Output directory for the generated files.")
+		hostURL       = flag.String("hosturl", "", "URL of the MikroTik router (default $ROS_HOSTURL or $MIKROTIK_HOST).")
+		username      = flag.String("username", "", "Username for the MikroTik (default $ROS_USERNAME or $MIKROTIK_USER).")
+		password      = flag.String("password", "", "Password for the MikroTik user (default $ROS_PASSWORD or $MIKROTIK_PASSWORD).")
+		caCertificate = flag.String("ca_certificate", "", "Path to MikroTik's certificate authority file.")
+		insecure      = flag.Bool("insecure", false, "Do not verify the SSL certificate.")
+		only          = flag.String("resources", "", "Comma-separated list of resource types to generate (default all).")
+	)
+	flag.Parse()
+
+	raw := map[string]interface{}{}
+	for k, v := range map[string]string{
+		"hosturl":        *hostURL,
+		"username":       *username,
+		"password":       *password,
+		"ca_certificate": *caCertificate,
+	} {
+		if v != "" {
+			raw[k] = v
+		}
+	}
+	if *insecure {
+		raw["insecure"] = true
+	}
+
+	p := routeros.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		for _, d := range diags {
+			log.Printf("%v: %v", d.Summary, d.Detail)
+		}
+		os.Exit(1)
+	}
+	c := p.Meta().(routeros.Client)
+
+	var filter map[string]struct{}
+	if *only != "" {
+		filter = make(map[string]struct{})
+		for _, t := range strings.Split(*only, ",") {
+			filter[strings.TrimSpace(t)] = struct{}{}
+		}
+	}
+
+	g := newGenerator(p.ResourcesMap)
+	for _, t := range g.types {
+		if filter != nil {
+			if _, ok := filter[t]; !ok {
+				continue
+			}
+		}
+		if err := g.read(t, c); err != nil {
+			log.Printf("[%v] %v", t, err)
+		}
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	files := g.files()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(*outDir, name), files[name], 0o644); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(filepath.Join(*outDir, name))
+	}
+}
+
+// canonicalTypes Selects one resource type per RouterOS menu: deprecated aliases that were kept for compatibility
+// (routeros_bridge, routeros_firewall_filter, ...) and authoritative rulesets share the menu with
+// the canonical resource.
+func canonicalTypes(resources map[string]*schema.Resource) []string {
+	byPath := map[string]string{}
+	for t, r := range resources {
+		if strings.HasSuffix(t, "_ruleset") || r.DeprecationMessage != "" {
+			continue
+		}
+		path := routeros.GetMetadata(r.Schema).Path
+		if path == "" {
+			continue
+		}
+		// The canonical name is the longest one: routeros_interface_bridge vs routeros_bridge.
+		if prev, ok := byPath[path]; !ok || len(t) > len(prev) || (len(t) == len(prev) && t < prev) {
+			byPath[path] = t
+		}
+	}
+
+	res := make([]string, 0, len(byPath))
+	for _, t := range byPath {
+		res = append(res, t)
+	}
+	sort.Strings(res)
+	return res
+}